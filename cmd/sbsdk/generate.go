@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	KIND_ACTION  = "action"
+	KIND_TRIGGER = "trigger"
+)
+
+const SDK_MODULE = "github.com/switchboard-org/plugin-sdk"
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// namePattern matches the action names and trigger keys accepted by add. They are used as hcl
+// identifiers by the runner, and snake_case keeps them consistent across providers.
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+
+var majorVersionPattern = regexp.MustCompile(`^v[0-9]+$`)
+
+// generatedFile is a file to be created, and the template that renders it.
+type generatedFile struct {
+	name     string
+	template string
+}
+
+// templateData is passed to every template.
+type templateData struct {
+	//Module is the module path of a new provider
+	Module string
+	//SDKVersion is the version of the SDK required by a new provider, if known
+	SDKVersion string
+	//Package is the name of the provider's package
+	Package string
+	//Name is the action name or trigger key
+	Name string
+	//Type is Name in CamelCase, used as the prefix of generated Go identifiers
+	Type string
+}
+
+// scaffoldProvider creates a provider module in dir, and returns the paths of the files it created.
+func scaffoldProvider(dir string, module string) ([]string, error) {
+	if err := checkModulePath(module); err != nil {
+		return nil, err
+	}
+	data := templateData{
+		Module:     module,
+		SDKVersion: sdkVersion(),
+		Package:    "main",
+	}
+	return generate(dir, data, []generatedFile{
+		{name: "go.mod", template: "go.mod.tmpl"},
+		{name: "main.go", template: "main.go.tmpl"},
+		{name: "provider.go", template: "provider.go.tmpl"},
+		{name: "provider_test.go", template: "provider_test.go.tmpl"},
+	})
+}
+
+// scaffoldComponent adds an action or trigger to the provider in dir, and returns the paths of the
+// files it created. The generated code registers itself in the options declared by provider.go.
+func scaffoldComponent(dir string, kind string, name string) ([]string, error) {
+	if !namePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid %s name %q: use snake_case, such as send_message", kind, name)
+	}
+	pkg, err := packageName(dir)
+	if err != nil {
+		return nil, err
+	}
+	data := templateData{
+		Package: pkg,
+		Name:    name,
+		Type:    camelCase(name),
+	}
+	base := kind + "_" + name
+	return generate(dir, data, []generatedFile{
+		{name: base + ".go", template: kind + ".go.tmpl"},
+		{name: base + "_test.go", template: kind + "_test.go.tmpl"},
+	})
+}
+
+// generate renders files into dir. Nothing is written if any of them already exists.
+func generate(dir string, data templateData, files []generatedFile) ([]string, error) {
+	contents := make([][]byte, 0, len(files))
+	for _, file := range files {
+		target := filepath.Join(dir, file.name)
+		if _, err := os.Stat(target); err == nil {
+			return nil, fmt.Errorf("%s already exists", target)
+		}
+		var buf bytes.Buffer
+		if err := templates.ExecuteTemplate(&buf, file.template, data); err != nil {
+			return nil, err
+		}
+		content := buf.Bytes()
+		if strings.HasSuffix(file.name, ".go") {
+			formatted, err := format.Source(content)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file.name, err)
+			}
+			content = formatted
+		}
+		contents = append(contents, content)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	created := make([]string, 0, len(files))
+	for i, file := range files {
+		target := filepath.Join(dir, file.name)
+		if err := os.WriteFile(target, contents[i], 0o644); err != nil {
+			return created, err
+		}
+		created = append(created, target)
+	}
+	return created, nil
+}
+
+// packageName returns the package of the Go files in dir, which must contain a provider created by init.
+func packageName(dir string) (string, error) {
+	file := filepath.Join(dir, "provider.go")
+	parsed, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("%s does not exist: run sbsdk init to create a provider first", file)
+		}
+		return "", err
+	}
+	return parsed.Name.Name, nil
+}
+
+// checkModulePath rejects module paths that go.mod can't hold.
+func checkModulePath(module string) error {
+	if module == "" || strings.HasPrefix(module, "/") || strings.HasSuffix(module, "/") {
+		return fmt.Errorf("invalid module path %q", module)
+	}
+	for _, r := range module {
+		if unicode.IsSpace(r) || r == '"' || r == '\\' {
+			return fmt.Errorf("invalid module path %q", module)
+		}
+	}
+	return nil
+}
+
+// defaultDir is the directory init creates a provider in when none is given: the last element of
+// the module path, ignoring a major version suffix such as /v2.
+func defaultDir(module string) string {
+	dir := path.Base(module)
+	if majorVersionPattern.MatchString(dir) && path.Dir(module) != "." {
+		dir = path.Base(path.Dir(module))
+	}
+	return dir
+}
+
+// sdkVersion is the version of the SDK this command was built from, or "" when it was built from
+// a checkout with local changes, in which case `go mod tidy` picks the latest version.
+func sdkVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	version := info.Main.Version
+	if info.Main.Path != SDK_MODULE {
+		version = ""
+		for _, dep := range info.Deps {
+			if dep.Path == SDK_MODULE {
+				version = dep.Version
+			}
+		}
+	}
+	if version == "(devel)" || strings.Contains(version, "+") {
+		return ""
+	}
+	return version
+}
+
+// camelCase converts a snake_case name into CamelCase, such as send_message into SendMessage.
+func camelCase(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		runes := []rune(part)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScaffold(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "provider")
+	if _, err := scaffoldProvider(dir, "example.com/provider"); err != nil {
+		t.Fatal(err)
+	}
+	created, err := scaffoldComponent(dir, KIND_ACTION, "send_message")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 2 || filepath.Base(created[0]) != "action_send_message.go" {
+		t.Errorf("got files %q", created)
+	}
+	src, err := os.ReadFile(created[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(src), "func evaluateSendMessage(") {
+		t.Errorf("got action\n%s", src)
+	}
+	if _, err := scaffoldComponent(dir, KIND_TRIGGER, "new_message"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := scaffoldComponent(dir, KIND_ACTION, "send_message"); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("got %v adding send_message twice, want an error", err)
+	}
+}
+
+func TestScaffoldErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := scaffoldComponent(dir, KIND_ACTION, "send_message"); err == nil || !strings.Contains(err.Error(), "sbsdk init") {
+		t.Errorf("got %v adding an action without a provider", err)
+	}
+	for _, name := range []string{"SendMessage", "send-message", "send__message", "1st"} {
+		if _, err := scaffoldComponent(dir, KIND_ACTION, name); err == nil || !strings.Contains(err.Error(), "invalid") {
+			t.Errorf("got %v for name %q, want it rejected", err, name)
+		}
+	}
+	if _, err := scaffoldProvider(dir, "example.com/my provider"); err == nil {
+		t.Error("got no error for a module path with a space")
+	}
+}
+
+func TestDefaultDir(t *testing.T) {
+	for module, want := range map[string]string{
+		"example.com/provider":    "provider",
+		"example.com/provider/v2": "provider",
+		"provider":                "provider",
+		"v2":                      "v2",
+	} {
+		if got := defaultDir(module); got != want {
+			t.Errorf("got %q for %q, want %q", got, module, want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Command sbsdk scaffolds Switchboard providers built on the plugin SDK.
+//
+// Usage:
+//
+//	sbsdk init [-dir dir] <module path>
+//	sbsdk add action [-dir dir] <name>
+//	sbsdk add trigger [-dir dir] <key>
+//
+// init creates a provider module with a main.go that serves the provider, and a provider.go that
+// builds it with sbsdk.NewProvider. add creates an action or trigger, along with a test, in an
+// existing provider. Generated files are never overwritten.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+const usage = `sbsdk scaffolds Switchboard providers.
+
+Usage:
+
+	sbsdk init [-dir dir] <module path>    create a provider module
+	sbsdk add action [-dir dir] <name>     add an action to the provider in dir
+	sbsdk add trigger [-dir dir] <key>     add a trigger to the provider in dir
+`
+
+// errUsage is returned for invalid arguments, after the usage of the command has been printed.
+var errUsage = errors.New("invalid arguments")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "sbsdk:", err)
+		}
+		os.Exit(2)
+	}
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return errUsage
+	}
+	switch args[0] {
+	case "init":
+		return runInit(args[1:], stdout, stderr)
+	case "add":
+		if len(args) < 2 {
+			fmt.Fprint(stderr, usage)
+			return errUsage
+		}
+		switch args[1] {
+		case "action":
+			return runAdd(KIND_ACTION, args[2:], stdout, stderr)
+		case "trigger":
+			return runAdd(KIND_TRIGGER, args[2:], stdout, stderr)
+		}
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return nil
+	}
+	fmt.Fprint(stderr, usage)
+	return errUsage
+}
+
+func runInit(args []string, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("init", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", "", "directory to create the provider in (default: the last element of the module path)")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: sbsdk init [-dir dir] <module path>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errUsage
+	}
+	module := flags.Arg(0)
+	if *dir == "" {
+		*dir = defaultDir(module)
+	}
+	files, err := scaffoldProvider(*dir, module)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		fmt.Fprintln(stdout, "created", file)
+	}
+	fmt.Fprintf(stdout, "\nRun `go mod tidy` in %s to fetch the SDK, then `sbsdk add action <name>` to add an action.\n", *dir)
+	return nil
+}
+
+func runAdd(kind string, args []string, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("add "+kind, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", ".", "directory of the provider")
+	argName := "name"
+	if kind == KIND_TRIGGER {
+		argName = "key"
+	}
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: sbsdk add %s [-dir dir] <%s>\n", kind, argName)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errUsage
+	}
+	files, err := scaffoldComponent(*dir, kind, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		fmt.Fprintln(stdout, "created", file)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package sbsdk
+
+import (
+	"context"
+)
+
+// AdaptProvider wraps a Provider so it can be served as a ProviderV3. Provider implementations
+// cannot observe the runner's context, so the adapter only guarantees that the runner gets its
+// answer on time: a call whose context is done before it starts is never made, and a call whose
+// context is done while it runs returns ctx.Err() immediately while the wrapped call finishes in
+// the background. Providers that need real cancellation should implement ProviderV3 directly.
+func AdaptProvider(p Provider) ProviderV3 {
+	return &providerAdapter{impl: p}
+}
+
+type providerAdapter struct {
+	impl Provider
+}
+
+// Init hands the wrapped Provider a RunnerProvider whose methods return the zero value when the
+// call to the runner fails.
+func (a *providerAdapter) Init(ctx context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error) {
+	return callWithContext(ctx, func() (ProviderConfig, error) {
+		return a.impl.Init(&legacyRunnerProvider{impl: runnerProvider})
+	})
+}
+
+func (a *providerAdapter) InitSchema(ctx context.Context) (ObjectSchema, error) {
+	return callWithContext(ctx, a.impl.InitSchema)
+}
+
+func (a *providerAdapter) ActionNames(ctx context.Context) ([]string, error) {
+	return callWithContext(ctx, a.impl.ActionNames)
+}
+
+// ActionEvaluate never reports sensitive paths, because Provider has no way to return them.
+func (a *providerAdapter) ActionEvaluate(ctx context.Context, contextId string, name string, input []byte) (ActionOutput, error) {
+	return callWithContext(ctx, func() (ActionOutput, error) {
+		output, err := a.impl.ActionEvaluate(contextId, name, input)
+		return ActionOutput{Value: output}, err
+	})
+}
+
+func (a *providerAdapter) ActionConfigurationSchema(ctx context.Context, name string) (ObjectSchema, error) {
+	return callWithContext(ctx, func() (ObjectSchema, error) {
+		return a.impl.ActionConfigurationSchema(name)
+	})
+}
+
+func (a *providerAdapter) ActionOutputType(ctx context.Context, name string) (Type, error) {
+	return callWithContext(ctx, func() (Type, error) {
+		return a.impl.ActionOutputType(name)
+	})
+}
+
+// ActionMetadata is always empty, because Provider has no way to describe its actions.
+func (a *providerAdapter) ActionMetadata(ctx context.Context, _ string) (Metadata, error) {
+	return Metadata{}, ctx.Err()
+}
+
+func (a *providerAdapter) TriggerKeyNames(ctx context.Context) ([]string, error) {
+	return callWithContext(ctx, a.impl.TriggerKeyNames)
+}
+
+func (a *providerAdapter) TriggerConfigurationSchema(ctx context.Context) (ObjectSchema, error) {
+	return callWithContext(ctx, a.impl.TriggerConfigurationSchema)
+}
+
+func (a *providerAdapter) MapPayloadToTriggerKey(ctx context.Context, payload []byte) (string, error) {
+	return callWithContext(ctx, func() (string, error) {
+		return a.impl.MapPayloadToTriggerKey(payload)
+	})
+}
+
+func (a *providerAdapter) TriggerOutputType(ctx context.Context, key string) (Type, error) {
+	return callWithContext(ctx, func() (Type, error) {
+		return a.impl.TriggerOutputType(key)
+	})
+}
+
+// TriggerMetadata is always empty, because Provider has no way to describe its triggers.
+func (a *providerAdapter) TriggerMetadata(ctx context.Context, _ string) (Metadata, error) {
+	return Metadata{}, ctx.Err()
+}
+
+func (a *providerAdapter) CreateSubscription(ctx context.Context, contextId string, input []byte) ([]byte, error) {
+	return callWithContext(ctx, func() ([]byte, error) {
+		return a.impl.CreateSubscription(contextId, input)
+	})
+}
+
+func (a *providerAdapter) ReadSubscription(ctx context.Context, contextId string, subscriptionId string) ([]byte, error) {
+	return callWithContext(ctx, func() ([]byte, error) {
+		return a.impl.ReadSubscription(contextId, subscriptionId)
+	})
+}
+
+func (a *providerAdapter) UpdateSubscription(ctx context.Context, contextId string, subscriptionId string, input []byte) ([]byte, error) {
+	return callWithContext(ctx, func() ([]byte, error) {
+		return a.impl.UpdateSubscription(contextId, subscriptionId, input)
+	})
+}
+
+func (a *providerAdapter) DeleteSubscription(ctx context.Context, contextId string, subscriptionId string) error {
+	_, err := callWithContext(ctx, func() (struct{}, error) {
+		return struct{}{}, a.impl.DeleteSubscription(contextId, subscriptionId)
+	})
+	return err
+}
+
+// callWithContext runs fn in the background and returns its result, or ctx.Err() as soon as
+// ctx is done, whichever comes first.
+func callWithContext[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+	type result struct {
+		val T
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		val, err := fn()
+		done <- result{val: val, err: err}
+	}()
+	select {
+	case r := <-done:
+		return r.val, r.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
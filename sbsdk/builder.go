@@ -0,0 +1,160 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrUnknownAction is returned by providers built with NewProvider when the runner asks
+// for an action name that was never registered. It reaches the runner as a diagnostic with
+// DIAG_CODE_UNKNOWN_ACTION, so errors.Is recognises it on both sides of the plugin boundary.
+var ErrUnknownAction = errors.New("unknown action")
+
+// ErrUnknownTrigger is returned by a TriggerRegistry, and so by providers built with NewProvider,
+// when the runner asks for a trigger key that was never registered. Like ErrUnknownAction, it is
+// recognised by errors.Is on both sides of the plugin boundary.
+var ErrUnknownTrigger = errors.New("unknown trigger")
+
+// ProviderOption configures a Provider created by NewProvider.
+type ProviderOption func(*builtProvider)
+
+// WithInitSchema sets the schema returned from Provider.InitSchema. Providers that need no
+// configuration can omit it, and an empty schema will be returned.
+func WithInitSchema(schema ObjectSchema) ProviderOption {
+	return func(p *builtProvider) {
+		p.initSchema = schema
+	}
+}
+
+// WithInit sets the function called from Provider.Init. Use it to hold on to the RunnerProvider
+// or to return a non-default ProviderConfig.
+func WithInit(init func(ctx context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error)) ProviderOption {
+	return func(p *builtProvider) {
+		p.init = init
+	}
+}
+
+// WithAction registers an Action under the given name. Registering the same name twice
+// replaces the earlier action.
+func WithAction(name string, action Action) ProviderOption {
+	return func(p *builtProvider) {
+		p.actions[name] = action
+	}
+}
+
+// WithTrigger registers a Trigger under the given key. Registering the same key twice
+// replaces the earlier trigger. See TriggerRegistry.Register for the keys that are accepted.
+func WithTrigger(key string, trigger Trigger) ProviderOption {
+	return func(p *builtProvider) {
+		p.Register(key, trigger)
+	}
+}
+
+// NewProvider builds a ProviderV3 from registered Action and Trigger implementations, so that plugins
+// don't have to hand-write dispatch by name or conversions between raw JSON and cty.Value.
+func NewProvider(opts ...ProviderOption) ProviderV3 {
+	p := &builtProvider{
+		TriggerRegistry: NewTriggerRegistry(),
+		initSchema:      ObjectSchema{},
+		actions:         make(map[string]Action),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+type builtProvider struct {
+	*TriggerRegistry
+	init       func(ctx context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error)
+	initSchema ObjectSchema
+	actions    map[string]Action
+}
+
+func (p *builtProvider) Init(ctx context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error) {
+	if p.init == nil {
+		return ProviderConfig{}, nil
+	}
+	return p.init(ctx, runnerProvider)
+}
+
+func (p *builtProvider) InitSchema(_ context.Context) (ObjectSchema, error) {
+	if diags := CheckSchema(&p.initSchema); diags.HasErrors() {
+		return ObjectSchema{}, diags
+	}
+	return p.initSchema, nil
+}
+
+func (p *builtProvider) ActionNames(_ context.Context) ([]string, error) {
+	names := make([]string, 0, len(p.actions))
+	for name := range p.actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (p *builtProvider) ActionEvaluate(ctx context.Context, contextId string, name string, input []byte) (ActionOutput, error) {
+	action, err := p.action(name)
+	if err != nil {
+		return ActionOutput{}, err
+	}
+	schema, err := action.ConfigurationSchema()
+	if err != nil {
+		return ActionOutput{}, err
+	}
+	inputVal, err := MapInputToCtyValue(input, schema)
+	if err != nil {
+		return ActionOutput{}, err
+	}
+	outputVal, err := action.Evaluate(ctx, contextId, inputVal)
+	if err != nil {
+		return ActionOutput{}, redactError(err, inputVal)
+	}
+	outputType, err := action.OutputType()
+	if err != nil {
+		return ActionOutput{}, err
+	}
+	return mapCtyValueToActionOutput(outputVal, outputType)
+}
+
+func (p *builtProvider) ActionConfigurationSchema(_ context.Context, name string) (ObjectSchema, error) {
+	action, err := p.action(name)
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	schema, err := action.ConfigurationSchema()
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	if diags := CheckSchema(&schema); diags.HasErrors() {
+		return ObjectSchema{}, diags
+	}
+	return schema, nil
+}
+
+func (p *builtProvider) ActionOutputType(_ context.Context, name string) (Type, error) {
+	action, err := p.action(name)
+	if err != nil {
+		return Type{}, err
+	}
+	return action.OutputType()
+}
+
+func (p *builtProvider) ActionMetadata(_ context.Context, name string) (Metadata, error) {
+	action, err := p.action(name)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return metadataOf(action), nil
+}
+
+func (p *builtProvider) action(name string) (Action, error) {
+	action, ok := p.actions[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownAction, name)
+	}
+	return action, nil
+}
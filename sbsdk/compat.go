@@ -0,0 +1,193 @@
+package sbsdk
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// Equals reports whether t and other describe the same type, including their optional
+// attributes, defaults and sensitivity. Metadata is documentation, and is not compared.
+// Equals, AssignableTo and CheckAssignableTo have value receivers, like their argument, so they
+// can be called on the Types returned by constructors and methods without taking an address.
+func (t Type) Equals(other Type) bool {
+	if t.TypeName != other.TypeName || t.Sensitive != other.Sensitive {
+		return false
+	}
+	switch t.TypeName {
+	case OBJECT_TYPE:
+		nested, otherNested := t.nestedValues(), other.nestedValues()
+		if len(nested) != len(otherNested) {
+			return false
+		}
+		for name, v := range nested {
+			otherV, ok := otherNested[name]
+			if !ok || !v.Equals(otherV) {
+				return false
+			}
+		}
+		optional, otherOptional := t.optionalAttrs(), other.optionalAttrs()
+		if len(optional) != len(otherOptional) {
+			return false
+		}
+		sort.Strings(optional)
+		sort.Strings(otherOptional)
+		for i := range optional {
+			if optional[i] != otherOptional[i] {
+				return false
+			}
+		}
+		return t.defaultsEqual(other)
+	case LIST_TYPE, SET_TYPE, MAP_TYPE:
+		elementType, otherElementType := t.elementType(), other.elementType()
+		return elementType.Equals(otherElementType)
+	case TUPLE_TYPE:
+		var elements, otherElements []Type
+		if t.ElementTypes != nil {
+			elements = *t.ElementTypes
+		}
+		if other.ElementTypes != nil {
+			otherElements = *other.ElementTypes
+		}
+		if len(elements) != len(otherElements) {
+			return false
+		}
+		for i := range elements {
+			if !elements[i].Equals(otherElements[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// AssignableTo reports whether values of t can be used where target is expected, such as when
+// one action's output is wired into another action's input. It follows the conversion rules of
+// cty's convert package, which the SDK uses to convert values at runtime, so a string is
+// assignable to a number even though not every string value converts. Use CheckAssignableTo
+// to find out why a type is not assignable.
+func (t Type) AssignableTo(target Type) bool {
+	return canConvert(t.valueType(), target.ToCty())
+}
+
+// CheckAssignableTo returns an error Diagnostic for each part of t that can't be converted to
+// target, with the Attribute of each pointing at the incompatible attribute or element, and the
+// Detail explaining why. Paths into lists, sets and maps use an unknown index. No Diagnostics are
+// returned when t is AssignableTo target.
+func (t Type) CheckAssignableTo(target Type) Diagnostics {
+	return explainMismatch(t.valueType(), target.ToCty(), nil)
+}
+
+func explainMismatch(from, to cty.Type, path cty.Path) Diagnostics {
+	if canConvert(from, to) {
+		return nil
+	}
+	var diags Diagnostics
+	switch {
+	case from.IsObjectType() && to.IsObjectType():
+		names := make([]string, 0, len(to.AttributeTypes()))
+		for name := range to.AttributeTypes() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fromAttr, ok := from.AttributeTypes()[name]
+			if !ok {
+				if !to.AttributeOptional(name) {
+					diags = append(diags, mismatchDiagnostic(path.GetAttr(name), "attribute is required, but is not provided"))
+				}
+				continue
+			}
+			diags = append(diags, explainMismatch(fromAttr, to.AttributeType(name), path.GetAttr(name))...)
+		}
+	case from.IsCollectionType() && to.IsCollectionType():
+		diags = explainMismatch(from.ElementType(), to.ElementType(), path.Index(cty.DynamicVal))
+	case from.IsTupleType() && (to.IsListType() || to.IsSetType()) && to.ElementType() != cty.DynamicPseudoType:
+		for i, el := range from.TupleElementTypes() {
+			diags = append(diags, explainMismatch(el, to.ElementType(), path.IndexInt(i))...)
+		}
+	case from.IsObjectType() && to.IsMapType() && to.ElementType() != cty.DynamicPseudoType:
+		names := make([]string, 0, len(from.AttributeTypes()))
+		for name := range from.AttributeTypes() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			diags = append(diags, explainMismatch(from.AttributeType(name), to.ElementType(), path.Index(cty.StringVal(name)))...)
+		}
+	case from.IsTupleType() && to.IsTupleType() && from.Length() == to.Length():
+		for i, el := range from.TupleElementTypes() {
+			diags = append(diags, explainMismatch(el, to.TupleElementType(i), path.IndexInt(i))...)
+		}
+	}
+	if len(diags) == 0 {
+		diags = Diagnostics{mismatchDiagnostic(path, convert.MismatchMessage(from, to))}
+	}
+	return diags
+}
+
+// canConvert reports whether convert.Convert can convert some values of from to to. cty has
+// no conversion for types that need none, so equal types are checked first.
+func canConvert(from, to cty.Type) bool {
+	return from.Equals(to) || convert.GetConversionUnsafe(from, to) != nil
+}
+
+func mismatchDiagnostic(path cty.Path, reason string) Diagnostic {
+	return Diagnostic{
+		Severity:  DiagError,
+		Summary:   "Incompatible types",
+		Detail:    reason,
+		Attribute: path,
+	}
+}
+
+func (t *Type) nestedValues() map[string]Type {
+	if t.NestedValues == nil {
+		return nil
+	}
+	return *t.NestedValues
+}
+
+// elementType is the InternalType of a list, set or map, which ToCty treats as a string when it's missing.
+func (t *Type) elementType() Type {
+	if t.InternalType == nil {
+		return String
+	}
+	return *t.InternalType
+}
+
+// defaultsEqual reports whether the object types t and other have equal default values.
+func (t *Type) defaultsEqual(other Type) bool {
+	var defaults, otherDefaults map[string]json.RawMessage
+	if t.Defaults != nil {
+		defaults = *t.Defaults
+	}
+	if other.Defaults != nil {
+		otherDefaults = *other.Defaults
+	}
+	if len(defaults) != len(otherDefaults) {
+		return false
+	}
+	for name, data := range defaults {
+		otherData, ok := otherDefaults[name]
+		attrType, declared := t.nestedValues()[name]
+		if !ok || !declared {
+			return false
+		}
+		ctyType := attrType.valueType()
+		val, err := ctyjson.Unmarshal(data, ctyType)
+		if err != nil {
+			return false
+		}
+		otherVal, err := ctyjson.Unmarshal(otherData, ctyType)
+		if err != nil || !val.RawEquals(otherVal) {
+			return false
+		}
+	}
+	return true
+}
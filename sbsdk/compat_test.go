@@ -0,0 +1,106 @@
+package sbsdk
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestTypeEquals(t *testing.T) {
+	sensitiveString := String
+	sensitiveString.Sensitive = true
+	withDefault, err := ObjectWithDefaults(map[string]Type{"count": Number}, map[string]cty.Value{"count": cty.NumberIntVal(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherDefault, err := ObjectWithDefaults(map[string]Type{"count": Number}, map[string]cty.Value{"count": cty.NumberIntVal(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		a, b Type
+		want bool
+	}{
+		{"primitives", String, String, true},
+		{"different primitives", String, Number, false},
+		{"sensitivity", String, sensitiveString, false},
+		{"lists", List(Map(Bool)), List(Map(Bool)), true},
+		{"list and set", List(String), Set(String), false},
+		{"tuples", Tuple(String, Number), Tuple(String, Number), true},
+		{"tuple lengths", Tuple(String), Tuple(String, Number), false},
+		{
+			name: "optional attributes in any order",
+			a:    ObjectWithOptionalAttrs(map[string]Type{"a": String, "b": String}, "a", "b"),
+			b:    ObjectWithOptionalAttrs(map[string]Type{"a": String, "b": String}, "b", "a"),
+			want: true,
+		},
+		{
+			name: "optional attributes",
+			a:    ObjectWithOptionalAttrs(map[string]Type{"a": String}, "a"),
+			b:    Object(map[string]Type{"a": String}),
+		},
+		{"defaults", withDefault, withDefault, true},
+		{"different defaults", withDefault, otherDefault, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equals(tt.b); got != tt.want {
+				t.Errorf("got %t comparing %s to %s", got, tt.a, tt.b)
+			}
+			if got := tt.b.Equals(tt.a); got != tt.want {
+				t.Errorf("got %t comparing %s to %s", got, tt.b, tt.a)
+			}
+		})
+	}
+}
+
+func TestAssignableTo(t *testing.T) {
+	user := Object(map[string]Type{"name": String, "age": Number})
+	tests := []struct {
+		name     string
+		from, to Type
+		want     bool
+	}{
+		{"same type", user, user, true},
+		{"string to number", String, Number, true},
+		{"list to string", List(String), String, false},
+		{"dropped attribute", user, Object(map[string]Type{"name": String}), true},
+		{"missing attribute", Object(map[string]Type{"name": String}), user, false},
+		{"missing optional attribute", Object(map[string]Type{"name": String}), ObjectWithOptionalAttrs(map[string]Type{"name": String, "age": Number}, "age"), true},
+		{"object to map", Object(map[string]Type{"a": String, "b": Number}), Map(String), true},
+		{"anything to dynamic", user, Dynamic, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.from.AssignableTo(tt.to); got != tt.want {
+				t.Errorf("got %t assigning %s to %s", got, tt.from, tt.to)
+			}
+			if diags := tt.from.CheckAssignableTo(tt.to); diags.HasErrors() == tt.want {
+				t.Errorf("got diagnostics %v assigning %s to %s", diags, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+func TestCheckAssignableToPointsAtTheMismatch(t *testing.T) {
+	from := Object(map[string]Type{
+		"users": List(Object(map[string]Type{"tags": List(String)})),
+	})
+	to := Object(map[string]Type{
+		"users": List(Object(map[string]Type{"tags": String, "id": String})),
+	})
+	diags := from.CheckAssignableTo(to)
+	if len(diags) != 2 {
+		t.Fatalf("got diagnostics %#v, want 2", diags)
+	}
+	want := []cty.Path{
+		cty.GetAttrPath("users").Index(cty.DynamicVal).GetAttr("id"),
+		cty.GetAttrPath("users").Index(cty.DynamicVal).GetAttr("tags"),
+	}
+	for i, path := range want {
+		if !diags[i].Attribute.Equals(path) {
+			t.Errorf("got diagnostic %d at %s, want %s", i, formatPath(diags[i].Attribute), formatPath(path))
+		}
+	}
+}
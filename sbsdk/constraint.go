@@ -0,0 +1,203 @@
+package sbsdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	CONSTRAINT_EXACTLY_ONE_OF  = "exactly_one_of"
+	CONSTRAINT_AT_LEAST_ONE_OF = "at_least_one_of"
+	CONSTRAINT_CONFLICTS_WITH  = "conflicts_with"
+	CONSTRAINT_REQUIRED_WITH   = "required_with"
+)
+
+// Constraint is a serializable rule about which attributes of an ObjectSchema may be set
+// together. An attribute or block is set when its value is not null, and, for repeated
+// blocks, when at least one block is present. Create one with ExactlyOneOf, AtLeastOneOf,
+// ConflictsWith or RequiredWith, and add it to a schema with ObjectSchema.AddConstraints.
+type Constraint struct {
+	//Kind is one of the CONSTRAINT constants
+	Kind string `json:"kind"`
+	//Attribute is the attribute a CONSTRAINT_CONFLICTS_WITH or CONSTRAINT_REQUIRED_WITH
+	//constraint applies to, and is empty for the other kinds
+	Attribute string `json:"attribute,omitempty"`
+	//With are the attributes the constraint is checked against
+	With []string `json:"with"`
+}
+
+// ExactlyOneOf requires exactly one of attrs to be set.
+func ExactlyOneOf(attrs ...string) Constraint {
+	return Constraint{
+		Kind: CONSTRAINT_EXACTLY_ONE_OF,
+		With: attrs,
+	}
+}
+
+// AtLeastOneOf requires one or more of attrs to be set.
+func AtLeastOneOf(attrs ...string) Constraint {
+	return Constraint{
+		Kind: CONSTRAINT_AT_LEAST_ONE_OF,
+		With: attrs,
+	}
+}
+
+// ConflictsWith forbids any of others from being set when attr is set.
+func ConflictsWith(attr string, others ...string) Constraint {
+	return Constraint{
+		Kind:      CONSTRAINT_CONFLICTS_WITH,
+		Attribute: attr,
+		With:      others,
+	}
+}
+
+// RequiredWith requires every one of others to be set when attr is set.
+func RequiredWith(attr string, others ...string) Constraint {
+	return Constraint{
+		Kind:      CONSTRAINT_REQUIRED_WITH,
+		Attribute: attr,
+		With:      others,
+	}
+}
+
+// AddConstraints adds constraints to the schema. They are enforced by ValidateConfig.
+func (s *ObjectSchema) AddConstraints(constraints ...Constraint) {
+	s.Constraints = append(s.Constraints, constraints...)
+}
+
+// check reports a constraint that can never be checked, such as one naming an attribute that
+// isn't in schema, so that CheckSchema catches it before any configuration does.
+func (c *Constraint) check(schema *ObjectSchema, path cty.Path) Diagnostics {
+	switch c.Kind {
+	case CONSTRAINT_EXACTLY_ONE_OF, CONSTRAINT_AT_LEAST_ONE_OF:
+		if c.Attribute != "" {
+			return c.invalid(path, fmt.Sprintf("The %s constraint doesn't take an attribute, but has %q.", c.Kind, c.Attribute))
+		}
+	case CONSTRAINT_CONFLICTS_WITH, CONSTRAINT_REQUIRED_WITH:
+		if c.Attribute == "" {
+			return c.invalid(path, fmt.Sprintf("The %s constraint needs the attribute it applies to.", c.Kind))
+		}
+	default:
+		return c.invalid(path, fmt.Sprintf("Unsupported constraint kind %q.", c.Kind))
+	}
+	if len(c.With) == 0 {
+		return c.invalid(path, fmt.Sprintf("The %s constraint names no attributes to check.", c.Kind))
+	}
+	for _, attr := range append([]string{c.Attribute}, c.With...) {
+		if _, ok := schema.Attributes[attr]; attr != "" && !ok {
+			return c.invalid(path, fmt.Sprintf("The %s constraint names %q, which isn't an attribute or block of the object.", c.Kind, attr))
+		}
+	}
+	return nil
+}
+
+func (c *Constraint) invalid(path cty.Path, detail string) Diagnostics {
+	return Diagnostics{{
+		Severity:  DiagError,
+		Summary:   "Invalid constraint",
+		Detail:    detail + " This is a bug in the provider's schema.",
+		Attribute: path,
+	}}
+}
+
+// validateConstraints checks the constraints of schema against val, which must be a known,
+// non-null object.
+func validateConstraints(schema *ObjectSchema, val cty.Value, path cty.Path) Diagnostics {
+	var diags Diagnostics
+	for _, constraint := range schema.Constraints {
+		if !constraintKnown(schema, val, append([]string{constraint.Attribute}, constraint.With...)) {
+			//whether the constraint holds can't be known until the values are
+			continue
+		}
+		var set []string
+		for _, attr := range constraint.With {
+			if isSet, _ := attrIsSet(schema, val, attr); isSet {
+				set = append(set, attr)
+			}
+		}
+		attrSet, _ := attrIsSet(schema, val, constraint.Attribute)
+		switch constraint.Kind {
+		case CONSTRAINT_EXACTLY_ONE_OF:
+			if len(set) == 1 {
+				continue
+			}
+			diagPath := path
+			if len(set) > 1 {
+				diagPath = path.GetAttr(set[1])
+			}
+			diags = append(diags, invalidCombination(diagPath, fmt.Sprintf("Exactly one of %s must be set.", formatAttrs(constraint.With))))
+		case CONSTRAINT_AT_LEAST_ONE_OF:
+			if len(set) > 0 {
+				continue
+			}
+			diags = append(diags, invalidCombination(path, fmt.Sprintf("At least one of %s must be set.", formatAttrs(constraint.With))))
+		case CONSTRAINT_CONFLICTS_WITH:
+			if !attrSet {
+				continue
+			}
+			for _, other := range set {
+				diags = append(diags, invalidCombination(path.GetAttr(other), fmt.Sprintf("%q cannot be set together with %q.", other, constraint.Attribute)))
+			}
+		case CONSTRAINT_REQUIRED_WITH:
+			if !attrSet {
+				continue
+			}
+			for _, other := range constraint.With {
+				if isSet, _ := attrIsSet(schema, val, other); !isSet {
+					diags = append(diags, invalidCombination(path.GetAttr(constraint.Attribute), fmt.Sprintf("%q must be set when %q is set.", other, constraint.Attribute)))
+				}
+			}
+		default:
+			diags = append(diags, constraint.invalid(path, fmt.Sprintf("Unsupported constraint kind %q.", constraint.Kind))...)
+		}
+	}
+	return diags
+}
+
+// attrIsSet reports whether an attribute of val is set, and whether that can be known yet.
+// Attributes that aren't in the object are never set.
+func attrIsSet(schema *ObjectSchema, val cty.Value, attr string) (bool, bool) {
+	if attr == "" || !val.Type().HasAttribute(attr) {
+		return false, true
+	}
+	attrVal := val.GetAttr(attr)
+	if !attrVal.IsKnown() {
+		return false, false
+	}
+	if attrVal.IsNull() {
+		return false, true
+	}
+	if block, ok := schema.Attributes[attr].(*BlockSchema); ok && block.Mode != BLOCK_MODE_SINGLE && block.Mode != "" {
+		return attrVal.LengthInt() > 0, true
+	}
+	return true, true
+}
+
+// constraintKnown reports whether every one of attrs is known, so a constraint on them can be checked.
+func constraintKnown(schema *ObjectSchema, val cty.Value, attrs []string) bool {
+	for _, attr := range attrs {
+		if _, known := attrIsSet(schema, val, attr); !known {
+			return false
+		}
+	}
+	return true
+}
+
+func invalidCombination(path cty.Path, detail string) Diagnostic {
+	return Diagnostic{
+		Severity:  DiagError,
+		Summary:   "Invalid combination of arguments",
+		Detail:    detail,
+		Attribute: path,
+	}
+}
+
+func formatAttrs(attrs []string) string {
+	quoted := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		quoted = append(quoted, fmt.Sprintf("%q", attr))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,175 @@
+package sbsdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func constrainedSchema() *ObjectSchema {
+	schema := &ObjectSchema{Attributes: map[string]Schema{
+		"id":       OptionalAttrSchema("id", String),
+		"email":    OptionalAttrSchema("email", String),
+		"token":    OptionalAttrSchema("token", String),
+		"username": OptionalAttrSchema("username", String),
+		"password": OptionalAttrSchema("password", String),
+		"tag":      ListBlockSchema("tag", &ObjectSchema{Attributes: map[string]Schema{"name": RequiredAttrSchema("name", String)}}, 0, 0),
+	}}
+	schema.AddConstraints(
+		ExactlyOneOf("id", "email"),
+		AtLeastOneOf("token", "username", "tag"),
+		ConflictsWith("token", "username", "password"),
+		RequiredWith("username", "password"),
+	)
+	return schema
+}
+
+func constrainedValue(attrs map[string]cty.Value) cty.Value {
+	val := map[string]cty.Value{
+		"id":       cty.NullVal(cty.String),
+		"email":    cty.NullVal(cty.String),
+		"token":    cty.NullVal(cty.String),
+		"username": cty.NullVal(cty.String),
+		"password": cty.NullVal(cty.String),
+		"tag":      cty.ListValEmpty(cty.Object(map[string]cty.Type{"name": cty.String})),
+	}
+	for k, v := range attrs {
+		val[k] = v
+	}
+	return cty.ObjectVal(val)
+}
+
+func TestConstraints(t *testing.T) {
+	schema := constrainedSchema()
+	if diags := CheckSchema(schema); diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	tag := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("a")})})
+
+	valid := map[string]map[string]cty.Value{
+		"token":                 {"id": cty.StringVal("1"), "token": cty.StringVal("t")},
+		"username and password": {"email": cty.StringVal("a@b.c"), "username": cty.StringVal("u"), "password": cty.StringVal("p")},
+		"repeated block":        {"id": cty.StringVal("1"), "tag": tag},
+		"unknown values":        {"id": cty.UnknownVal(cty.String), "email": cty.StringVal("a@b.c"), "token": cty.UnknownVal(cty.String), "username": cty.StringVal("u"), "password": cty.StringVal("p")},
+	}
+	for name, attrs := range valid {
+		if diags := ValidateConfig(schema, constrainedValue(attrs)); len(diags) > 0 {
+			t.Errorf("%s: %s", name, diags)
+		}
+	}
+
+	invalid := map[string]struct {
+		attrs map[string]cty.Value
+		path  cty.Path
+	}{
+		"neither id nor email": {
+			attrs: map[string]cty.Value{"token": cty.StringVal("t")},
+			path:  cty.Path{},
+		},
+		"both id and email": {
+			attrs: map[string]cty.Value{"id": cty.StringVal("1"), "email": cty.StringVal("a@b.c"), "token": cty.StringVal("t")},
+			path:  cty.GetAttrPath("email"),
+		},
+		"no credentials": {
+			attrs: map[string]cty.Value{"id": cty.StringVal("1")},
+			path:  cty.Path{},
+		},
+		"token with password": {
+			attrs: map[string]cty.Value{"id": cty.StringVal("1"), "token": cty.StringVal("t"), "password": cty.StringVal("p")},
+			path:  cty.GetAttrPath("password"),
+		},
+		"username without password": {
+			attrs: map[string]cty.Value{"id": cty.StringVal("1"), "username": cty.StringVal("u")},
+			path:  cty.GetAttrPath("username"),
+		},
+	}
+	for name, tt := range invalid {
+		t.Run(name, func(t *testing.T) {
+			assertDiagnosticPath(t, ValidateConfig(schema, constrainedValue(tt.attrs)), tt.path)
+		})
+	}
+}
+
+func TestNestedConstraints(t *testing.T) {
+	nested := &ObjectSchema{Attributes: map[string]Schema{
+		"id":    OptionalAttrSchema("id", String),
+		"email": OptionalAttrSchema("email", String),
+	}}
+	nested.AddConstraints(ExactlyOneOf("id", "email"))
+	schema := &ObjectSchema{Attributes: map[string]Schema{"user": ListBlockSchema("user", nested, 0, 0)}}
+	user := func(id cty.Value, email cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"id": id, "email": email})
+	}
+	val := cty.ObjectVal(map[string]cty.Value{"user": cty.ListVal([]cty.Value{
+		user(cty.StringVal("1"), cty.NullVal(cty.String)),
+		user(cty.StringVal("2"), cty.StringVal("a@b.c")),
+	})})
+	assertDiagnosticPath(t, ValidateConfig(schema, val), cty.GetAttrPath("user").IndexInt(1).GetAttr("email"))
+}
+
+func TestCheckSchemaReportsInvalidConstraints(t *testing.T) {
+	tests := map[string]Constraint{
+		"unknown kind":          {Kind: "mutually_exclusive", With: []string{"id"}},
+		"unknown attribute":     ExactlyOneOf("id", "phone"),
+		"no attributes":         AtLeastOneOf(),
+		"missing attribute":     {Kind: CONSTRAINT_CONFLICTS_WITH, With: []string{"id"}},
+		"unexpected attribute":  {Kind: CONSTRAINT_EXACTLY_ONE_OF, Attribute: "id", With: []string{"email"}},
+		"unknown own attribute": RequiredWith("phone", "id"),
+	}
+	for name, constraint := range tests {
+		t.Run(name, func(t *testing.T) {
+			schema := &ObjectSchema{Attributes: map[string]Schema{
+				"id":    OptionalAttrSchema("id", String),
+				"email": OptionalAttrSchema("email", String),
+			}}
+			schema.AddConstraints(constraint)
+			block := &ObjectSchema{Attributes: map[string]Schema{"user": OptionalBlockSchema("user", schema)}}
+			assertDiagnosticPath(t, CheckSchema(block), cty.GetAttrPath("user"))
+		})
+	}
+}
+
+func TestConstraintsRoundTrip(t *testing.T) {
+	schema := ObjectSchema{Attributes: map[string]Schema{
+		"user": OptionalBlockSchema("user", constrainedSchema()),
+	}}
+	schema.AddConstraints(AtLeastOneOf("user"))
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			provider := dispense(t, &testProvider{schema: schema})
+			got, err := provider.InitSchema(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, schema) {
+				t.Errorf("got schema %#v, want %#v", got, schema)
+			}
+		})
+	}
+}
+
+func TestProtocolVersion2DropsConstraints(t *testing.T) {
+	schema := ObjectSchema{Attributes: map[string]Schema{
+		"user": OptionalBlockSchema("user", constrainedSchema()),
+	}}
+	schema.AddConstraints(AtLeastOneOf("user"))
+	provider := dispenseRPCV2(t, &testProvider{schema: schema})
+	got, err := provider.InitSchema(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := *constrainedSchema()
+	want.Constraints = nil
+	if len(got.Constraints) > 0 {
+		t.Errorf("got constraints %v", got.Constraints)
+	}
+	block, ok := got.Attributes["user"].(*BlockSchema)
+	if !ok {
+		t.Fatalf("got %#v, want the user block", got.Attributes["user"])
+	}
+	if !reflect.DeepEqual(block.Nested, &want) {
+		t.Errorf("got nested schema %#v, want %#v", block.Nested, &want)
+	}
+}
@@ -0,0 +1,159 @@
+package sbsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+)
+
+// REATTACH_ENV is the environment variable that Serve, in debug mode, tells users to set to the
+// provider's ReattachConfig. Runners read it with ReattachConfigFromEnv.
+const REATTACH_ENV = "SB_REATTACH_PROVIDER"
+
+// ReattachConfig is what a runner needs to connect to a provider that is already running, rather
+// than starting one. It's the JSON form of plugin.ReattachConfig, which can't be encoded because
+// of its net.Addr.
+type ReattachConfig struct {
+	Protocol        plugin.Protocol `json:"protocol"`
+	ProtocolVersion int             `json:"protocol_version"`
+	Pid             int             `json:"pid"`
+	//Test is set for providers served in debug mode, which the runner doesn't stop when it closes
+	//them, so that they can be reused by later runs
+	Test bool         `json:"test"`
+	Addr ReattachAddr `json:"addr"`
+}
+
+// ReattachAddr is the address a provider listens on.
+type ReattachAddr struct {
+	//Network is "unix" or "tcp"
+	Network string `json:"network"`
+	String  string `json:"string"`
+}
+
+// ReattachConfigFromEnv parses the ReattachConfig in REATTACH_ENV. It returns nil when the
+// variable isn't set.
+func ReattachConfigFromEnv() (*ReattachConfig, error) {
+	data := os.Getenv(REATTACH_ENV)
+	if data == "" {
+		return nil, nil
+	}
+	var config ReattachConfig
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", REATTACH_ENV, err)
+	}
+	return &config, nil
+}
+
+func reattachConfigFromPlugin(config *plugin.ReattachConfig) *ReattachConfig {
+	return &ReattachConfig{
+		Protocol:        config.Protocol,
+		ProtocolVersion: config.ProtocolVersion,
+		Pid:             config.Pid,
+		Test:            config.Test,
+		Addr: ReattachAddr{
+			Network: config.Addr.Network(),
+			String:  config.Addr.String(),
+		},
+	}
+}
+
+func (c *ReattachConfig) toPlugin() (*plugin.ReattachConfig, error) {
+	var addr net.Addr
+	var err error
+	switch c.Addr.Network {
+	case "unix":
+		addr, err = net.ResolveUnixAddr("unix", c.Addr.String)
+	case "tcp":
+		addr, err = net.ResolveTCPAddr("tcp", c.Addr.String)
+	default:
+		err = fmt.Errorf("unsupported network %q", c.Addr.Network)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid reattach address: %w", err)
+	}
+	return &plugin.ReattachConfig{
+		Protocol:        c.Protocol,
+		ProtocolVersion: c.ProtocolVersion,
+		Addr:            addr,
+		Pid:             c.Pid,
+		Test:            c.Test,
+	}, nil
+}
+
+// serveDebug serves the provider in go-plugin's test mode, in which the process runs on its own,
+// so that it can be started under a debugger. It prints the ReattachConfig for the runner, and
+// serves until the process is interrupted, letting the calls in progress finish first.
+func serveDebug(serveConfig *plugin.ServeConfig, served *servedProvider, config *serveConfig) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reattachCh := make(chan *plugin.ReattachConfig, 1)
+	closeCh := make(chan struct{})
+	serveConfig.Test = &plugin.ServeTestConfig{
+		Context:          ctx,
+		ReattachConfigCh: reattachCh,
+		CloseCh:          closeCh,
+	}
+	serveConfig.VersionedPlugins = newestPlugins(serveConfig.VersionedPlugins)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	go plugin.Serve(serveConfig)
+	select {
+	case reattach := <-reattachCh:
+		data, err := json.Marshal(reattachConfigFromPlugin(reattach))
+		if err != nil {
+			config.logger.Error("failed to encode reattach config", "error", err)
+			return
+		}
+		fmt.Printf("Provider started in debug mode, with process id %d. To have the runner connect to it, set:\n\n", reattach.Pid)
+		fmt.Printf("\t%s='%s'\n\n", REATTACH_ENV, data)
+		fmt.Println("The provider keeps running between runs of the runner until it's interrupted.")
+	case <-closeCh:
+		return
+	}
+	select {
+	case sig := <-signals:
+		config.logger.Info("shutting down", "signal", sig.String(), "timeout", config.shutdownTimeout.String())
+		if served.drain(config.shutdownTimeout) {
+			time.Sleep(responseFlushDelay)
+		} else {
+			config.logger.Warn("calls still in progress at shutdown timeout")
+		}
+		cancel()
+		<-closeCh
+	case <-closeCh:
+	}
+}
+
+// newestPlugins keeps only the newest protocol version of versioned. go-plugin negotiates the
+// version with the runner that starts the process, and serves the oldest one it's given when
+// there is none, as in debug mode, which would leave the provider on the legacy version 2.
+func newestPlugins(versioned map[int]plugin.PluginSet) map[int]plugin.PluginSet {
+	newest := 0
+	for version := range versioned {
+		if version > newest {
+			newest = version
+		}
+	}
+	return map[int]plugin.PluginSet{newest: versioned[newest]}
+}
+
+// debugLogger is the default logger of a provider served in debug mode, which writes to the
+// terminal it was started from rather than to the runner.
+func debugLogger() hclog.Logger {
+	return hclog.New(&hclog.LoggerOptions{
+		Name:   PROVIDER_PLUGIN_NAME,
+		Level:  hclog.LevelFromString(os.Getenv(LOG_LEVEL_ENV)),
+		Output: os.Stderr,
+		Color:  hclog.AutoColor,
+	})
+}
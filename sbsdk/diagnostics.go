@@ -0,0 +1,359 @@
+package sbsdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Severity tells the runner and CLI how to treat a Diagnostic.
+type Severity int
+
+const (
+	DiagInvalid Severity = iota
+	DiagError
+	DiagWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case DiagError:
+		return "error"
+	case DiagWarning:
+		return "warning"
+	default:
+		return "invalid"
+	}
+}
+
+const (
+	//DIAG_CODE_UNKNOWN_ACTION is the Code of the diagnostic made from ErrUnknownAction
+	DIAG_CODE_UNKNOWN_ACTION = "unknown_action"
+	//DIAG_CODE_UNKNOWN_TRIGGER is the Code of the diagnostic made from ErrUnknownTrigger
+	DIAG_CODE_UNKNOWN_TRIGGER = "unknown_trigger"
+)
+
+// diagCodes maps the errors that have a stable diagnostic code to that code.
+var diagCodes = map[error]string{
+	ErrUnknownAction:  DIAG_CODE_UNKNOWN_ACTION,
+	ErrUnknownTrigger: DIAG_CODE_UNKNOWN_TRIGGER,
+}
+
+// Diagnostic describes a single problem found by a provider, in enough detail for the CLI to
+// render it like an HCL diagnostic.
+type Diagnostic struct {
+	Severity Severity
+	//Summary is a short description of the problem, such as "Invalid value for attribute"
+	Summary string
+	//Detail is an optional longer explanation of the problem and how to fix it
+	Detail string
+	//Attribute is the path to the offending attribute within the value the provider was given,
+	//when the problem is tied to one
+	Attribute cty.Path
+	//Subject is the source range of the problem, when the provider knows it
+	Subject *hcl.Range
+	//Code identifies problems the runner may need to recognise, such as DIAG_CODE_UNKNOWN_ACTION,
+	//without relying on their wording. It is empty for most diagnostics.
+	Code string
+	//Kind and RetryAfter classify an error diagnostic, as described by ProviderError
+	Kind       ErrorKind
+	RetryAfter time.Duration
+}
+
+// Diagnostics is a list of Diagnostic, and is what providers return as their error when they have
+// more to say than an error string. It survives the trip across the plugin boundary intact, so the
+// runner can tell a configuration problem from a vendor failure.
+//
+// Diagnostics implements error, but a nil Diagnostics stored in an error is not a nil error.
+// Return Diagnostics.Err() instead of the Diagnostics themselves.
+type Diagnostics []Diagnostic
+
+// HasErrors reports whether any of the diagnostics has DiagError severity.
+func (d Diagnostics) HasErrors() bool {
+	for _, diag := range d {
+		if diag.Severity == DiagError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns the diagnostics as an error if any of them is an error, or nil otherwise.
+func (d Diagnostics) Err() error {
+	if !d.HasErrors() {
+		return nil
+	}
+	return d
+}
+
+func (d Diagnostics) Error() string {
+	var msgs []string
+	for _, diag := range d {
+		if diag.Severity != DiagError {
+			continue
+		}
+		msg := diag.Summary
+		if len(diag.Attribute) > 0 {
+			msg = fmt.Sprintf("%s: %s", formatPath(diag.Attribute), msg)
+		}
+		if diag.Detail != "" {
+			msg = fmt.Sprintf("%s; %s", msg, diag.Detail)
+		}
+		msgs = append(msgs, msg)
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Is reports whether any of the diagnostics has the code of target, which is one of the errors
+// with a DIAG_CODE constant, so that errors.Is(err, ErrUnknownAction) works on the runner side
+// of the plugin boundary too.
+func (d Diagnostics) Is(target error) bool {
+	code, ok := diagCodes[target]
+	if !ok {
+		return false
+	}
+	for _, diag := range d {
+		if diag.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap exposes every classified error diagnostic as a *ProviderError, so that ErrorKindOf,
+// RetryAfterOf and errors.As work on Diagnostics received from a provider.
+func (d Diagnostics) Unwrap() []error {
+	var errs []error
+	for _, diag := range d {
+		if diag.Severity != DiagError || diag.Kind == ErrorKindUnknown {
+			continue
+		}
+		errs = append(errs, &ProviderError{
+			Kind:       diag.Kind,
+			RetryAfter: diag.RetryAfter,
+			Err:        errors.New(diag.Summary),
+		})
+	}
+	return errs
+}
+
+// ToHCL converts the diagnostics so that the CLI can render them with hcl's diagnostic writer.
+// Diagnostics without a Subject have no source location.
+func (d Diagnostics) ToHCL() hcl.Diagnostics {
+	out := make(hcl.Diagnostics, 0, len(d))
+	for _, diag := range d {
+		severity := hcl.DiagInvalid
+		switch diag.Severity {
+		case DiagError:
+			severity = hcl.DiagError
+		case DiagWarning:
+			severity = hcl.DiagWarning
+		}
+		detail := diag.Detail
+		if len(diag.Attribute) > 0 && diag.Subject == nil {
+			detail = strings.TrimSpace(fmt.Sprintf("%s\n\nAttribute: %s", detail, formatPath(diag.Attribute)))
+		}
+		out = append(out, &hcl.Diagnostic{
+			Severity: severity,
+			Summary:  diag.Summary,
+			Detail:   detail,
+			Subject:  diag.Subject,
+		})
+	}
+	return out
+}
+
+// DiagnosticsFromHCL converts diagnostics produced by the hcl library.
+func DiagnosticsFromHCL(diags hcl.Diagnostics) Diagnostics {
+	out := make(Diagnostics, 0, len(diags))
+	for _, diag := range diags {
+		severity := DiagInvalid
+		switch diag.Severity {
+		case hcl.DiagError:
+			severity = DiagError
+		case hcl.DiagWarning:
+			severity = DiagWarning
+		}
+		out = append(out, Diagnostic{
+			Severity: severity,
+			Summary:  diag.Summary,
+			Detail:   diag.Detail,
+			Subject:  diag.Subject,
+		})
+	}
+	return out
+}
+
+// DiagnosticsFromError returns err unchanged if it is already Diagnostics, and otherwise wraps its
+// message in a single error Diagnostic. Its Code is set when err wraps one of the errors with a
+// DIAG_CODE constant, and it is classified by the ProviderError in err's chain if there is one.
+// A nil error gives nil Diagnostics.
+func DiagnosticsFromError(err error) Diagnostics {
+	if err == nil {
+		return nil
+	}
+	var diags Diagnostics
+	if errors.As(err, &diags) {
+		return diags
+	}
+	diag := Diagnostic{
+		Severity: DiagError,
+		Summary:  err.Error(),
+	}
+	for target, code := range diagCodes {
+		if errors.Is(err, target) {
+			diag.Code = code
+		}
+	}
+	var providerErr *ProviderError
+	if errors.As(err, &providerErr) {
+		diag.Kind = providerErr.Kind
+		diag.RetryAfter = providerErr.RetryAfter
+	}
+	return Diagnostics{diag}
+}
+
+// valueDiagnostics turns an error from decoding or encoding a cty value into Diagnostics,
+// pointing at the offending attribute when cty reports one.
+func valueDiagnostics(summary string, err error) Diagnostics {
+	diag := Diagnostic{
+		Severity: DiagError,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}
+	var pathErr cty.PathError
+	if errors.As(err, &pathErr) {
+		diag.Attribute = pathErr.Path
+	}
+	return Diagnostics{diag}
+}
+
+// formatPath renders a cty.Path in the same syntax a user would write it in hcl.
+func formatPath(path cty.Path) string {
+	var b strings.Builder
+	for _, step := range redactPath(path) {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(s.Name)
+		case cty.IndexStep:
+			switch {
+			case !s.Key.IsKnown() || s.Key.IsNull():
+				b.WriteString("[?]")
+			case s.Key.Type() == cty.String:
+				fmt.Fprintf(&b, "[%q]", s.Key.AsString())
+			case s.Key.Type() == cty.Number:
+				fmt.Fprintf(&b, "[%s]", s.Key.AsBigFloat().Text('f', -1))
+			}
+		}
+	}
+	return b.String()
+}
+
+// diagnosticJSON is the JSON representation of a Diagnostic, used by the net/rpc transport
+// and by anything that wants to print diagnostics in a machine-readable form.
+type diagnosticJSON struct {
+	Severity  string              `json:"severity"`
+	Summary   string              `json:"summary"`
+	Detail    string              `json:"detail,omitempty"`
+	Attribute []attributeStepJSON `json:"attribute,omitempty"`
+	Subject   *hcl.Range          `json:"subject,omitempty"`
+	Code      string              `json:"code,omitempty"`
+	Kind      string              `json:"kind,omitempty"`
+	// RetryAfterMillis is the RetryAfter duration in milliseconds
+	RetryAfterMillis int64 `json:"retry_after_millis,omitempty"`
+}
+
+type attributeStepJSON struct {
+	AttributeName    *string `json:"attribute_name,omitempty"`
+	ElementKeyString *string `json:"element_key_string,omitempty"`
+	ElementKeyInt    *int64  `json:"element_key_int,omitempty"`
+}
+
+func (d Diagnostic) MarshalJSON() ([]byte, error) {
+	out := diagnosticJSON{
+		Severity: d.Severity.String(),
+		Summary:  d.Summary,
+		Detail:   d.Detail,
+		Subject:  d.Subject,
+		Code:     d.Code,
+	}
+	if d.Kind != ErrorKindUnknown {
+		out.Kind = d.Kind.String()
+	}
+	out.RetryAfterMillis = d.RetryAfter.Milliseconds()
+	out.Attribute = attributePathToJSON(d.Attribute)
+	return json.Marshal(out)
+}
+
+func (d *Diagnostic) UnmarshalJSON(data []byte) error {
+	var in diagnosticJSON
+	err := json.Unmarshal(data, &in)
+	if err != nil {
+		return err
+	}
+	*d = Diagnostic{
+		Summary:    in.Summary,
+		Detail:     in.Detail,
+		Subject:    in.Subject,
+		Code:       in.Code,
+		Kind:       errorKindFromString(in.Kind),
+		RetryAfter: time.Duration(in.RetryAfterMillis) * time.Millisecond,
+	}
+	switch in.Severity {
+	case "error":
+		d.Severity = DiagError
+	case "warning":
+		d.Severity = DiagWarning
+	}
+	d.Attribute = attributePathFromJSON(in.Attribute)
+	return nil
+}
+
+// attributePathToJSON converts path to the JSON form used by diagnosticJSON, redacting
+// sensitive index keys. Steps that can't be represented, such as unknown keys, are dropped.
+func attributePathToJSON(path cty.Path) []attributeStepJSON {
+	var out []attributeStepJSON
+	for _, step := range redactPath(path) {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			name := s.Name
+			out = append(out, attributeStepJSON{AttributeName: &name})
+		case cty.IndexStep:
+			if !s.Key.IsKnown() || s.Key.IsNull() {
+				continue
+			}
+			switch s.Key.Type() {
+			case cty.String:
+				key := s.Key.AsString()
+				out = append(out, attributeStepJSON{ElementKeyString: &key})
+			case cty.Number:
+				key, _ := s.Key.AsBigFloat().Int64()
+				out = append(out, attributeStepJSON{ElementKeyInt: &key})
+			}
+		}
+	}
+	return out
+}
+
+func attributePathFromJSON(steps []attributeStepJSON) cty.Path {
+	var path cty.Path
+	for _, step := range steps {
+		switch {
+		case step.AttributeName != nil:
+			path = path.GetAttr(*step.AttributeName)
+		case step.ElementKeyString != nil:
+			path = path.Index(cty.StringVal(*step.ElementKeyString))
+		case step.ElementKeyInt != nil:
+			path = path.IndexInt(int(*step.ElementKeyInt))
+		}
+	}
+	return path
+}
@@ -0,0 +1,87 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDiagnosticsRoundTrip(t *testing.T) {
+	diags := Diagnostics{
+		{
+			Severity:  DiagError,
+			Summary:   "Invalid value for attribute",
+			Detail:    "The name must not be empty.",
+			Attribute: cty.GetAttrPath("users").IndexInt(1).GetAttr("labels").IndexString("team"),
+			Subject: &hcl.Range{
+				Filename: "main.hcl",
+				Start:    hcl.Pos{Line: 3, Column: 5, Byte: 20},
+				End:      hcl.Pos{Line: 3, Column: 9, Byte: 24},
+			},
+			Code: "custom_code",
+		},
+		{Severity: DiagWarning, Summary: "Deprecated attribute"},
+	}
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			provider := dispense(t, &testProvider{err: diags})
+			_, err := provider.ActionEvaluate(context.Background(), "ctx", "create_user", nil)
+			var got Diagnostics
+			if !errors.As(err, &got) {
+				t.Fatalf("got error %v, want Diagnostics", err)
+			}
+			if !reflect.DeepEqual(got, diags) {
+				t.Errorf("got diagnostics %#v, want %#v", got, diags)
+			}
+		})
+	}
+}
+
+func TestUnknownNamesKeepTheirCodeAcrossTransports(t *testing.T) {
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			provider := dispense(t, NewProvider())
+			ctx := context.Background()
+			_, err := provider.ActionOutputType(ctx, "missing")
+			if !errors.Is(err, ErrUnknownAction) || errors.Is(err, ErrUnknownTrigger) {
+				t.Errorf("got error %v, want ErrUnknownAction", err)
+			}
+			if diags := DiagnosticsFromError(err); len(diags) != 1 || diags[0].Code != DIAG_CODE_UNKNOWN_ACTION {
+				t.Errorf("got diagnostics %#v", diags)
+			}
+			_, err = provider.TriggerOutputType(ctx, "missing")
+			if !errors.Is(err, ErrUnknownTrigger) || errors.Is(err, ErrUnknownAction) {
+				t.Errorf("got error %v, want ErrUnknownTrigger", err)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsFromErrorKeepsDiagnostics(t *testing.T) {
+	diags := Diagnostics{{Severity: DiagError, Summary: "bad"}}
+	if got := DiagnosticsFromError(diags.Err()); !reflect.DeepEqual(got, diags) {
+		t.Errorf("got %#v, want %#v", got, diags)
+	}
+	got := DiagnosticsFromError(errors.New("vendor is down"))
+	if len(got) != 1 || got[0].Summary != "vendor is down" || got[0].Severity != DiagError || got[0].Code != "" {
+		t.Errorf("got %#v", got)
+	}
+	if DiagnosticsFromError(nil) != nil {
+		t.Error("got diagnostics for a nil error")
+	}
+}
+
+func assertDiagnosticPath(t *testing.T, err error, path cty.Path) {
+	t.Helper()
+	var diags Diagnostics
+	if !errors.As(err, &diags) || len(diags) != 1 {
+		t.Fatalf("got %v, want a single diagnostic", err)
+	}
+	if !diags[0].Attribute.Equals(path) {
+		t.Fatalf("got diagnostic at %s, want %s: %s", formatPath(diags[0].Attribute), formatPath(path), err)
+	}
+}
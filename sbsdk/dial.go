@@ -0,0 +1,143 @@
+package sbsdk
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+)
+
+// DialOption configures Dial.
+type DialOption func(*dialConfig)
+
+type dialConfig struct {
+	logger           hclog.Logger
+	allowedProtocols []plugin.Protocol
+	startTimeout     time.Duration
+	reattach         *ReattachConfig
+}
+
+// WithDialLogger sets the logger that go-plugin logs to, and that the provider's own log output
+// is forwarded to.
+func WithDialLogger(logger hclog.Logger) DialOption {
+	return func(c *dialConfig) {
+		c.logger = logger
+	}
+}
+
+// WithAllowedProtocols sets the protocols the provider may be served over, which Serve decides.
+// Both gRPC and net/rpc are allowed by default, and Dial fails for providers served over
+// others.
+func WithAllowedProtocols(protocols ...plugin.Protocol) DialOption {
+	return func(c *dialConfig) {
+		c.allowedProtocols = protocols
+	}
+}
+
+// WithStartTimeout sets how long Dial waits for the provider to start. go-plugin's default of
+// one minute is used when it's zero.
+func WithStartTimeout(timeout time.Duration) DialOption {
+	return func(c *dialConfig) {
+		c.startTimeout = timeout
+	}
+}
+
+// WithReattach connects to a provider that is already running, such as one served in debug mode,
+// rather than starting the one at the path given to Dial. Use ReattachConfigFromEnv to read the
+// config printed by a provider served with WithDebug.
+func WithReattach(config *ReattachConfig) DialOption {
+	return func(c *dialConfig) {
+		c.reattach = config
+	}
+}
+
+// ProviderClient is a provider running in a plugin process, as returned by Dial. It implements
+// ProviderV3 by calling the plugin, and Close stops the process.
+type ProviderClient struct {
+	ProviderV3
+	client *plugin.Client
+}
+
+// Close stops the plugin process, asking it to exit gracefully first. Providers served in debug
+// mode are left running.
+func (c *ProviderClient) Close() {
+	c.client.Kill()
+}
+
+// Exited reports whether the plugin process has exited.
+func (c *ProviderClient) Exited() bool {
+	return c.client.Exited()
+}
+
+// Protocol is the protocol the provider is served over, negotiated when it started.
+func (c *ProviderClient) Protocol() plugin.Protocol {
+	return c.client.Protocol()
+}
+
+// Dial starts the provider at path, the runner's side of Serve. It performs the handshake,
+// negotiates the protocol version and transport, and dispenses the provider, which is ready
+// for Init. Close the returned ProviderClient to stop the provider. path is not used when
+// connecting to a running provider with WithReattach.
+func Dial(path string, opts ...DialOption) (*ProviderClient, error) {
+	config := &dialConfig{
+		logger: hclog.New(&hclog.LoggerOptions{
+			Name:  PROVIDER_PLUGIN_NAME,
+			Level: hclog.LevelFromString(os.Getenv(LOG_LEVEL_ENV)),
+		}),
+		allowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC, plugin.ProtocolNetRPC},
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	clientConfig := &plugin.ClientConfig{
+		HandshakeConfig:  HandshakeConfig,
+		VersionedPlugins: VersionedPlugins(nil),
+		AllowedProtocols: config.allowedProtocols,
+		Logger:           config.logger,
+		StartTimeout:     config.startTimeout,
+	}
+	if config.reattach != nil {
+		reattach, err := config.reattach.toPlugin()
+		if err != nil {
+			return nil, err
+		}
+		//go-plugin only negotiates VersionedPlugins when it starts the process itself
+		plugins, ok := clientConfig.VersionedPlugins[reattach.ProtocolVersion]
+		if !ok {
+			return nil, fmt.Errorf("provider is served over unsupported protocol version %d", reattach.ProtocolVersion)
+		}
+		if _, ok := plugins[PROVIDER_PLUGIN_NAME].(plugin.GRPCPlugin); !ok && reattach.Protocol == plugin.ProtocolGRPC {
+			return nil, fmt.Errorf("protocol version %d is not served over %s", reattach.ProtocolVersion, plugin.ProtocolGRPC)
+		}
+		clientConfig.Plugins = plugins
+		clientConfig.Reattach = reattach
+	} else {
+		clientConfig.Cmd = exec.Command(path)
+		clientConfig.AutoMTLS = true
+	}
+	return dispense(plugin.NewClient(clientConfig))
+}
+
+// dispense connects to the plugin process managed by client, and dispenses the provider.
+// The process is stopped if that fails.
+func dispense(client *plugin.Client) (*ProviderClient, error) {
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, err
+	}
+	raw, err := rpcClient.Dispense(PROVIDER_PLUGIN_NAME)
+	if err != nil {
+		client.Kill()
+		return nil, err
+	}
+	provider, ok := raw.(ProviderV3)
+	if !ok {
+		client.Kill()
+		return nil, fmt.Errorf("plugin dispensed %T, which is not a ProviderV3", raw)
+	}
+	return &ProviderClient{ProviderV3: provider, client: client}, nil
+}
@@ -0,0 +1,138 @@
+package sbsdk
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+)
+
+// serveForReattach serves versioned in go-plugin's test mode, as Serve does in debug mode, and
+// returns the config to reattach to it.
+func serveForReattach(t *testing.T, versioned map[int]plugin.PluginSet, grpc bool) *ReattachConfig {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	reattachCh := make(chan *plugin.ReattachConfig, 1)
+	closeCh := make(chan struct{})
+	config := &plugin.ServeConfig{
+		HandshakeConfig:  HandshakeConfig,
+		VersionedPlugins: versioned,
+		Logger:           hclog.NewNullLogger(),
+		Test: &plugin.ServeTestConfig{
+			Context:          ctx,
+			ReattachConfigCh: reattachCh,
+			CloseCh:          closeCh,
+		},
+	}
+	if grpc {
+		config.GRPCServer = plugin.DefaultGRPCServer
+	}
+	go plugin.Serve(config)
+	t.Cleanup(func() {
+		cancel()
+		<-closeCh
+	})
+	select {
+	case reattach := <-reattachCh:
+		return reattachConfigFromPlugin(reattach)
+	case <-closeCh:
+		t.Fatal("provider stopped before serving")
+	case <-time.After(10 * time.Second):
+		t.Fatal("provider didn't start")
+	}
+	return nil
+}
+
+func TestDialReattach(t *testing.T) {
+	impl := NewProvider(WithAction("greet", NewTypedAction(greet)))
+	tests := map[string]struct {
+		versioned    map[int]plugin.PluginSet
+		grpc         bool
+		wantVersion  int
+		wantProtocol plugin.Protocol
+	}{
+		"debug mode over gRPC": {
+			versioned:    newestPlugins(VersionedPlugins(impl)),
+			grpc:         true,
+			wantVersion:  3,
+			wantProtocol: plugin.ProtocolGRPC,
+		},
+		"debug mode over net/rpc": {
+			versioned:    newestPlugins(VersionedPlugins(impl)),
+			wantVersion:  3,
+			wantProtocol: plugin.ProtocolNetRPC,
+		},
+		"version 2": {
+			versioned:    VersionedPlugins(impl),
+			grpc:         true,
+			wantVersion:  2,
+			wantProtocol: plugin.ProtocolNetRPC,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reattach := serveForReattach(t, tt.versioned, tt.grpc)
+			if reattach.ProtocolVersion != tt.wantVersion || reattach.Protocol != tt.wantProtocol {
+				t.Fatalf("got version %d over %s, want version %d over %s",
+					reattach.ProtocolVersion, reattach.Protocol, tt.wantVersion, tt.wantProtocol)
+			}
+			client, err := Dial("", WithReattach(reattach), WithDialLogger(hclog.NewNullLogger()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+			if client.Protocol() != tt.wantProtocol {
+				t.Errorf("got protocol %s, want %s", client.Protocol(), tt.wantProtocol)
+			}
+			names, err := client.ActionNames(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(names, []string{"greet"}) {
+				t.Errorf("got actions %q", names)
+			}
+		})
+	}
+}
+
+func TestDialReattachErrors(t *testing.T) {
+	tests := map[string]struct {
+		reattach *ReattachConfig
+		want     string
+	}{
+		"unknown version": {
+			reattach: &ReattachConfig{Protocol: plugin.ProtocolNetRPC, ProtocolVersion: 1, Addr: ReattachAddr{Network: "tcp", String: "127.0.0.1:1"}},
+			want:     "unsupported protocol version 1",
+		},
+		"version 2 over gRPC": {
+			reattach: &ReattachConfig{Protocol: plugin.ProtocolGRPC, ProtocolVersion: 2, Addr: ReattachAddr{Network: "tcp", String: "127.0.0.1:1"}},
+			want:     "not served over grpc",
+		},
+		"unknown network": {
+			reattach: &ReattachConfig{Protocol: plugin.ProtocolGRPC, ProtocolVersion: 3, Addr: ReattachAddr{Network: "udp", String: "127.0.0.1:1"}},
+			want:     "invalid reattach address",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Dial("", WithReattach(tt.reattach), WithDialLogger(hclog.NewNullLogger()))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewestPlugins(t *testing.T) {
+	got := newestPlugins(VersionedPlugins(nil))
+	if len(got) != 1 {
+		t.Fatalf("got versions %v, want only the newest", got)
+	}
+	if _, ok := got[3][PROVIDER_PLUGIN_NAME].(*ProviderPlugin); !ok {
+		t.Errorf("got %#v, want version 3", got)
+	}
+}
@@ -0,0 +1,131 @@
+package sbsdk
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrorKind classifies a failed call so that the runner can decide whether, and when, to retry it.
+type ErrorKind int
+
+const (
+	// ErrorKindUnknown is the kind of any error that was not classified by the provider.
+	ErrorKindUnknown ErrorKind = iota
+	// ErrorKindRetryable is a transient failure, such as a vendor 5xx or a timeout.
+	ErrorKindRetryable
+	// ErrorKindRateLimited means the vendor throttled the call, and it should be retried later.
+	ErrorKindRateLimited
+	// ErrorKindPermanent is a failure that will not succeed no matter how often it is retried.
+	ErrorKindPermanent
+	// ErrorKindUnauthorized means the vendor rejected the provider's credentials.
+	ErrorKindUnauthorized
+	// ErrorKindNotFound means the vendor has no record of the requested resource.
+	ErrorKindNotFound
+	// ErrorKindConflict means the vendor rejected the call because of the resource's current state.
+	ErrorKindConflict
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindRetryable:
+		return "retryable"
+	case ErrorKindRateLimited:
+		return "rate_limited"
+	case ErrorKindPermanent:
+		return "permanent"
+	case ErrorKindUnauthorized:
+		return "unauthorized"
+	case ErrorKindNotFound:
+		return "not_found"
+	case ErrorKindConflict:
+		return "conflict"
+	default:
+		return "unknown"
+	}
+}
+
+// ShouldRetry reports whether a call that failed with this kind of error may succeed if retried.
+func (k ErrorKind) ShouldRetry() bool {
+	return k == ErrorKindRetryable || k == ErrorKindRateLimited
+}
+
+func errorKindFromString(s string) ErrorKind {
+	for k := ErrorKindRetryable; k <= ErrorKindConflict; k++ {
+		if k.String() == s {
+			return k
+		}
+	}
+	return ErrorKindUnknown
+}
+
+// ProviderError is an error classified by the provider. Create one with Retryable, RateLimited,
+// Permanent, Unauthorized, NotFound or Conflict, and return it from any Provider method. It
+// survives the trip to the runner, where ErrorKindOf and RetryAfterOf read it back.
+type ProviderError struct {
+	Kind ErrorKind
+	// RetryAfter is how long the runner should wait before retrying, when the vendor said so.
+	// It is zero when the provider has no opinion.
+	RetryAfter time.Duration
+	Err        error
+}
+
+// Error returns the message of the wrapped error alone, so that wrapping an error to classify it
+// doesn't change what the user reads. The classification is in Kind and RetryAfter.
+func (e *ProviderError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable marks err as a transient failure. retryAfter may be zero.
+func Retryable(err error, retryAfter time.Duration) error {
+	return &ProviderError{Kind: ErrorKindRetryable, RetryAfter: retryAfter, Err: err}
+}
+
+// RateLimited marks err as a throttled call. retryAfter should be taken from the vendor's
+// response when it provides one, and may be zero otherwise.
+func RateLimited(err error, retryAfter time.Duration) error {
+	return &ProviderError{Kind: ErrorKindRateLimited, RetryAfter: retryAfter, Err: err}
+}
+
+// Permanent marks err as a failure that retrying cannot fix.
+func Permanent(err error) error {
+	return &ProviderError{Kind: ErrorKindPermanent, Err: err}
+}
+
+// Unauthorized marks err as a rejection of the provider's credentials.
+func Unauthorized(err error) error {
+	return &ProviderError{Kind: ErrorKindUnauthorized, Err: err}
+}
+
+// NotFound marks err as a request for a resource the vendor doesn't know about.
+func NotFound(err error) error {
+	return &ProviderError{Kind: ErrorKindNotFound, Err: err}
+}
+
+// Conflict marks err as a rejection caused by the current state of a resource.
+func Conflict(err error) error {
+	return &ProviderError{Kind: ErrorKindConflict, Err: err}
+}
+
+// ErrorKindOf returns the kind of the first ProviderError in err's chain, or ErrorKindUnknown
+// if there is none.
+func ErrorKindOf(err error) ErrorKind {
+	var providerErr *ProviderError
+	if errors.As(err, &providerErr) {
+		return providerErr.Kind
+	}
+	return ErrorKindUnknown
+}
+
+// RetryAfterOf returns the retry delay of the first ProviderError in err's chain, and whether
+// the provider asked for one.
+func RetryAfterOf(err error) (time.Duration, bool) {
+	var providerErr *ProviderError
+	if errors.As(err, &providerErr) && providerErr.RetryAfter > 0 {
+		return providerErr.RetryAfter, true
+	}
+	return 0, false
+}
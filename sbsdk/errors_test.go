@@ -0,0 +1,75 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestProviderErrorsRoundTrip(t *testing.T) {
+	tests := []struct {
+		err        error
+		kind       ErrorKind
+		retryAfter time.Duration
+	}{
+		{Retryable(errors.New("vendor returned 503"), 0), ErrorKindRetryable, 0},
+		{RateLimited(errors.New("vendor returned 429"), 30*time.Second), ErrorKindRateLimited, 30 * time.Second},
+		{Permanent(errors.New("invalid email")), ErrorKindPermanent, 0},
+		{Unauthorized(errors.New("token expired")), ErrorKindUnauthorized, 0},
+		{NotFound(errors.New("no such user")), ErrorKindNotFound, 0},
+		{Conflict(errors.New("user already exists")), ErrorKindConflict, 0},
+		{errors.New("unclassified"), ErrorKindUnknown, 0},
+	}
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			impl := &testProvider{}
+			provider := dispense(t, impl)
+			for _, test := range tests {
+				impl.err = fmt.Errorf("creating user: %w", test.err)
+				_, err := provider.CreateSubscription(context.Background(), "ctx", nil)
+				if err == nil {
+					t.Fatalf("got no error for %v", impl.err)
+				}
+				if err.Error() != impl.err.Error() {
+					t.Errorf("got message %q, want %q", err, impl.err)
+				}
+				if kind := ErrorKindOf(err); kind != test.kind {
+					t.Errorf("%v: got kind %s, want %s", impl.err, kind, test.kind)
+				}
+				retryAfter, ok := RetryAfterOf(err)
+				if retryAfter != test.retryAfter || ok != (test.retryAfter > 0) {
+					t.Errorf("%v: got retry after %s, %t", impl.err, retryAfter, ok)
+				}
+			}
+		})
+	}
+}
+
+func TestDiagnosticsFromErrorKeepsTheWholeMessage(t *testing.T) {
+	err := fmt.Errorf("creating user: %w", RateLimited(errors.New("vendor returned 429"), time.Minute))
+	diags := DiagnosticsFromError(err)
+	if len(diags) != 1 {
+		t.Fatalf("got %d diagnostics", len(diags))
+	}
+	diag := diags[0]
+	if diag.Summary != "creating user: vendor returned 429" {
+		t.Errorf("got summary %q", diag.Summary)
+	}
+	if diag.Kind != ErrorKindRateLimited || diag.RetryAfter != time.Minute {
+		t.Errorf("got kind %s and retry after %s", diag.Kind, diag.RetryAfter)
+	}
+}
+
+func TestErrorKindShouldRetry(t *testing.T) {
+	for k := ErrorKindUnknown; k <= ErrorKindConflict; k++ {
+		want := k == ErrorKindRetryable || k == ErrorKindRateLimited
+		if k.ShouldRetry() != want {
+			t.Errorf("%s.ShouldRetry() = %t", k, !want)
+		}
+		if k != ErrorKindUnknown && errorKindFromString(k.String()) != k {
+			t.Errorf("%s does not survive its string form", k)
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package sbsdk
 
+// RunnerProvider is implemented by the runner and served back to the provider over the plugin
+// broker, so providers may call it at any time after Init, not just during it.
+//
+// A call that can't reach the runner, such as one made after the plugin has been re-initialised
+// with a new RunnerProvider, returns the zero value.
 type RunnerProvider interface {
 	//UserConfig is a map of byte string arrays, where each byte string array should conform to the Schema
 	//as specified by Provider.InitSchema method when marshaled into cty.JSON. Each key in the map is a context ID
@@ -8,6 +13,54 @@ type RunnerProvider interface {
 	GlobalConfig() GlobalConfig
 }
 
+// RunnerProviderV3 is the RunnerProvider handed to ProviderV3.Init. Its methods return the error
+// of a call that failed to reach the runner, rather than a zero value. AdaptProvider hands the
+// Provider it wraps a RunnerProvider that calls this one.
+type RunnerProviderV3 interface {
+	UserConfig() (map[string][]byte, error)
+	GlobalConfig() (GlobalConfig, error)
+}
+
+// AdaptRunnerProvider wraps a RunnerProvider so a runner can pass it to ProviderV3.Init. The
+// wrapped methods never fail.
+func AdaptRunnerProvider(r RunnerProvider) RunnerProviderV3 {
+	return &runnerProviderAdapter{impl: r}
+}
+
+type runnerProviderAdapter struct {
+	impl RunnerProvider
+}
+
+func (a *runnerProviderAdapter) UserConfig() (map[string][]byte, error) {
+	return a.impl.UserConfig(), nil
+}
+
+func (a *runnerProviderAdapter) GlobalConfig() (GlobalConfig, error) {
+	return a.impl.GlobalConfig(), nil
+}
+
+// legacyRunnerProvider is the RunnerProvider AdaptProvider hands to the Provider it wraps. Its
+// methods return the zero value when the call fails.
+type legacyRunnerProvider struct {
+	impl RunnerProviderV3
+}
+
+func (l *legacyRunnerProvider) UserConfig() map[string][]byte {
+	result, err := l.impl.UserConfig()
+	if err != nil {
+		return nil
+	}
+	return result
+}
+
+func (l *legacyRunnerProvider) GlobalConfig() GlobalConfig {
+	result, err := l.impl.GlobalConfig()
+	if err != nil {
+		return GlobalConfig{}
+	}
+	return result
+}
+
 // GlobalConfig contains details related to the core switchboard instance
 // that each provider may use as they see fit in their implementations
 type GlobalConfig struct {
@@ -0,0 +1,747 @@
+package sbsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/switchboard-org/plugin-sdk/sbsdk/sbproto"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc"
+)
+
+// ProviderGRPCClient is the runner side of the gRPC transport. It implements ProviderV3 by
+// translating every call into the equivalent sbproto.ProviderClient request. gRPC carries the
+// deadline and cancellation of each call's context to the plugin.
+type ProviderGRPCClient struct {
+	client sbproto.ProviderClient
+	broker *plugin.GRPCBroker
+
+	//mu guards runnerServer, the server of the RunnerProvider passed to the latest successful Init
+	mu           sync.Mutex
+	runnerServer *runnerServer
+}
+
+// Init serves runnerProvider on a new broker stream and tells the plugin which stream
+// to dial, so that the plugin can call back into the runner for as long as it runs.
+// Once Init succeeds, the stream served for an earlier Init is closed, and when it fails,
+// the new stream is closed instead.
+func (p *ProviderGRPCClient) Init(ctx context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error) {
+	brokerId := p.broker.NextId()
+	server := &runnerServer{}
+	go p.broker.AcceptAndServe(brokerId, func(opts []grpc.ServerOption) *grpc.Server {
+		s := grpc.NewServer(opts...)
+		sbproto.RegisterRunnerServer(s, &RunnerProviderGRPCServer{Impl: runnerProvider})
+		server.serving(s.Stop)
+		return s
+	})
+	resp, err := p.client.Init(ctx, &sbproto.Init_Request{
+		RunnerBrokerId: brokerId,
+	})
+	if err != nil {
+		server.Stop()
+		return ProviderConfig{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		server.Stop()
+		return ProviderConfig{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	p.mu.Lock()
+	previous := p.runnerServer
+	p.runnerServer = server
+	p.mu.Unlock()
+	if previous != nil {
+		previous.Stop()
+	}
+	return ProviderConfig{
+		SubscriptionsRegisteredTogether: resp.SubscriptionsRegisteredTogether,
+	}, nil
+}
+
+func (p *ProviderGRPCClient) InitSchema(ctx context.Context) (ObjectSchema, error) {
+	resp, err := p.client.InitSchema(ctx, &sbproto.InitSchema_Request{})
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return ObjectSchema{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return unmarshalObjectSchema(resp.Schema)
+}
+
+func (p *ProviderGRPCClient) ActionNames(ctx context.Context) ([]string, error) {
+	resp, err := p.client.ActionNames(ctx, &sbproto.ActionNames_Request{})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return nil, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return resp.Names, nil
+}
+
+func (p *ProviderGRPCClient) ActionEvaluate(ctx context.Context, contextId string, name string, input []byte) (ActionOutput, error) {
+	resp, err := p.client.ActionEvaluate(ctx, &sbproto.ActionEvaluate_Request{
+		ContextId: contextId,
+		Name:      name,
+		Input:     input,
+	})
+	if err != nil {
+		return ActionOutput{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return ActionOutput{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	output := ActionOutput{Value: resp.Output}
+	for _, path := range resp.SensitivePaths {
+		output.SensitivePaths = append(output.SensitivePaths, attributePathFromProto(path))
+	}
+	return output, nil
+}
+
+func (p *ProviderGRPCClient) ActionConfigurationSchema(ctx context.Context, name string) (ObjectSchema, error) {
+	resp, err := p.client.ActionConfigurationSchema(ctx, &sbproto.ActionConfigurationSchema_Request{Name: name})
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return ObjectSchema{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return unmarshalObjectSchema(resp.Schema)
+}
+
+func (p *ProviderGRPCClient) ActionOutputType(ctx context.Context, name string) (Type, error) {
+	resp, err := p.client.ActionOutputType(ctx, &sbproto.ActionOutputType_Request{Name: name})
+	if err != nil {
+		return Type{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return Type{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return unmarshalType(resp.Type)
+}
+
+func (p *ProviderGRPCClient) ActionMetadata(ctx context.Context, name string) (Metadata, error) {
+	resp, err := p.client.ActionMetadata(ctx, &sbproto.ActionMetadata_Request{Name: name})
+	if err != nil {
+		return Metadata{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return Metadata{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return metadataFromProto(resp.Metadata), nil
+}
+
+func (p *ProviderGRPCClient) TriggerKeyNames(ctx context.Context) ([]string, error) {
+	resp, err := p.client.TriggerKeyNames(ctx, &sbproto.TriggerKeyNames_Request{})
+	if err != nil {
+		return []string{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return []string{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return resp.Names, nil
+}
+
+func (p *ProviderGRPCClient) TriggerConfigurationSchema(ctx context.Context) (ObjectSchema, error) {
+	resp, err := p.client.TriggerConfigurationSchema(ctx, &sbproto.TriggerConfigurationSchema_Request{})
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return ObjectSchema{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return unmarshalObjectSchema(resp.Schema)
+}
+
+func (p *ProviderGRPCClient) MapPayloadToTriggerKey(ctx context.Context, data []byte) (string, error) {
+	resp, err := p.client.MapPayloadToTriggerKey(ctx, &sbproto.MapPayloadToTriggerKey_Request{Payload: data})
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return "", diagnosticsFromProto(resp.Diagnostics)
+	}
+	return resp.Key, nil
+}
+
+func (p *ProviderGRPCClient) TriggerOutputType(ctx context.Context, key string) (Type, error) {
+	resp, err := p.client.TriggerOutputType(ctx, &sbproto.TriggerOutputType_Request{Key: key})
+	if err != nil {
+		return Type{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return Type{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return unmarshalType(resp.Type)
+}
+
+func (p *ProviderGRPCClient) TriggerMetadata(ctx context.Context, key string) (Metadata, error) {
+	resp, err := p.client.TriggerMetadata(ctx, &sbproto.TriggerMetadata_Request{Key: key})
+	if err != nil {
+		return Metadata{}, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return Metadata{}, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return metadataFromProto(resp.Metadata), nil
+}
+
+func (p *ProviderGRPCClient) CreateSubscription(ctx context.Context, contextId string, input []byte) ([]byte, error) {
+	resp, err := p.client.CreateSubscription(ctx, &sbproto.CreateSubscription_Request{
+		ContextId: contextId,
+		Input:     input,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return nil, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return resp.State, nil
+}
+
+func (p *ProviderGRPCClient) ReadSubscription(ctx context.Context, contextId string, subscriptionId string) ([]byte, error) {
+	resp, err := p.client.ReadSubscription(ctx, &sbproto.ReadSubscription_Request{
+		ContextId:      contextId,
+		SubscriptionId: subscriptionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return nil, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return resp.State, nil
+}
+
+func (p *ProviderGRPCClient) UpdateSubscription(ctx context.Context, contextId string, subscriptionId string, input []byte) ([]byte, error) {
+	resp, err := p.client.UpdateSubscription(ctx, &sbproto.UpdateSubscription_Request{
+		ContextId:      contextId,
+		SubscriptionId: subscriptionId,
+		Input:          input,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return nil, diagnosticsFromProto(resp.Diagnostics)
+	}
+	return resp.State, nil
+}
+
+func (p *ProviderGRPCClient) DeleteSubscription(ctx context.Context, contextId string, subscriptionId string) error {
+	resp, err := p.client.DeleteSubscription(ctx, &sbproto.DeleteSubscription_Request{
+		ContextId:      contextId,
+		SubscriptionId: subscriptionId,
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.Diagnostics) > 0 {
+		return diagnosticsFromProto(resp.Diagnostics)
+	}
+	return nil
+}
+
+// ProviderGRPCServer is the plugin side of the gRPC transport. It implements
+// sbproto.ProviderServer by delegating to a ProviderV3 implementation.
+type ProviderGRPCServer struct {
+	Impl   ProviderV3
+	broker *plugin.GRPCBroker
+
+	//mu guards runnerConn, the connection to the RunnerProvider passed to the latest successful Init
+	mu         sync.Mutex
+	runnerConn *grpc.ClientConn
+}
+
+func (p *ProviderGRPCServer) Init(ctx context.Context, req *sbproto.Init_Request) (*sbproto.Init_Response, error) {
+	conn, err := p.broker.Dial(req.RunnerBrokerId)
+	if err != nil {
+		return &sbproto.Init_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	result, err := p.Impl.Init(ctx, &RunnerProviderGRPCClient{client: sbproto.NewRunnerClient(conn)})
+	if err != nil {
+		conn.Close()
+		return &sbproto.Init_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	p.mu.Lock()
+	previous := p.runnerConn
+	p.runnerConn = conn
+	p.mu.Unlock()
+	if previous != nil {
+		previous.Close()
+	}
+	return &sbproto.Init_Response{
+		SubscriptionsRegisteredTogether: result.SubscriptionsRegisteredTogether,
+	}, nil
+}
+
+func (p *ProviderGRPCServer) InitSchema(ctx context.Context, _ *sbproto.InitSchema_Request) (*sbproto.InitSchema_Response, error) {
+	result, err := p.Impl.InitSchema(ctx)
+	if err != nil {
+		return &sbproto.InitSchema_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	data, err := marshalObjectSchema(result)
+	if err != nil {
+		return &sbproto.InitSchema_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.InitSchema_Response{Schema: data}, nil
+}
+
+func (p *ProviderGRPCServer) ActionNames(ctx context.Context, _ *sbproto.ActionNames_Request) (*sbproto.ActionNames_Response, error) {
+	result, err := p.Impl.ActionNames(ctx)
+	if err != nil {
+		return &sbproto.ActionNames_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.ActionNames_Response{Names: result}, nil
+}
+
+func (p *ProviderGRPCServer) ActionEvaluate(ctx context.Context, req *sbproto.ActionEvaluate_Request) (*sbproto.ActionEvaluate_Response, error) {
+	result, err := p.Impl.ActionEvaluate(ctx, req.ContextId, req.Name, req.Input)
+	if err != nil {
+		return &sbproto.ActionEvaluate_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	resp := &sbproto.ActionEvaluate_Response{Output: result.Value}
+	for _, path := range result.SensitivePaths {
+		resp.SensitivePaths = append(resp.SensitivePaths, attributePathToProto(path))
+	}
+	return resp, nil
+}
+
+func (p *ProviderGRPCServer) ActionConfigurationSchema(ctx context.Context, req *sbproto.ActionConfigurationSchema_Request) (*sbproto.ActionConfigurationSchema_Response, error) {
+	result, err := p.Impl.ActionConfigurationSchema(ctx, req.Name)
+	if err != nil {
+		return &sbproto.ActionConfigurationSchema_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	data, err := marshalObjectSchema(result)
+	if err != nil {
+		return &sbproto.ActionConfigurationSchema_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.ActionConfigurationSchema_Response{Schema: data}, nil
+}
+
+func (p *ProviderGRPCServer) ActionOutputType(ctx context.Context, req *sbproto.ActionOutputType_Request) (*sbproto.ActionOutputType_Response, error) {
+	result, err := p.Impl.ActionOutputType(ctx, req.Name)
+	if err != nil {
+		return &sbproto.ActionOutputType_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return &sbproto.ActionOutputType_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.ActionOutputType_Response{Type: data}, nil
+}
+
+func (p *ProviderGRPCServer) ActionMetadata(ctx context.Context, req *sbproto.ActionMetadata_Request) (*sbproto.ActionMetadata_Response, error) {
+	result, err := p.Impl.ActionMetadata(ctx, req.Name)
+	if err != nil {
+		return &sbproto.ActionMetadata_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.ActionMetadata_Response{Metadata: metadataToProto(result)}, nil
+}
+
+func (p *ProviderGRPCServer) TriggerKeyNames(ctx context.Context, _ *sbproto.TriggerKeyNames_Request) (*sbproto.TriggerKeyNames_Response, error) {
+	result, err := p.Impl.TriggerKeyNames(ctx)
+	if err != nil {
+		return &sbproto.TriggerKeyNames_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.TriggerKeyNames_Response{Names: result}, nil
+}
+
+func (p *ProviderGRPCServer) TriggerConfigurationSchema(ctx context.Context, _ *sbproto.TriggerConfigurationSchema_Request) (*sbproto.TriggerConfigurationSchema_Response, error) {
+	result, err := p.Impl.TriggerConfigurationSchema(ctx)
+	if err != nil {
+		return &sbproto.TriggerConfigurationSchema_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	data, err := marshalObjectSchema(result)
+	if err != nil {
+		return &sbproto.TriggerConfigurationSchema_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.TriggerConfigurationSchema_Response{Schema: data}, nil
+}
+
+func (p *ProviderGRPCServer) MapPayloadToTriggerKey(ctx context.Context, req *sbproto.MapPayloadToTriggerKey_Request) (*sbproto.MapPayloadToTriggerKey_Response, error) {
+	result, err := p.Impl.MapPayloadToTriggerKey(ctx, req.Payload)
+	if err != nil {
+		return &sbproto.MapPayloadToTriggerKey_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.MapPayloadToTriggerKey_Response{Key: result}, nil
+}
+
+func (p *ProviderGRPCServer) TriggerOutputType(ctx context.Context, req *sbproto.TriggerOutputType_Request) (*sbproto.TriggerOutputType_Response, error) {
+	result, err := p.Impl.TriggerOutputType(ctx, req.Key)
+	if err != nil {
+		return &sbproto.TriggerOutputType_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return &sbproto.TriggerOutputType_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.TriggerOutputType_Response{Type: data}, nil
+}
+
+func (p *ProviderGRPCServer) TriggerMetadata(ctx context.Context, req *sbproto.TriggerMetadata_Request) (*sbproto.TriggerMetadata_Response, error) {
+	result, err := p.Impl.TriggerMetadata(ctx, req.Key)
+	if err != nil {
+		return &sbproto.TriggerMetadata_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.TriggerMetadata_Response{Metadata: metadataToProto(result)}, nil
+}
+
+func (p *ProviderGRPCServer) CreateSubscription(ctx context.Context, req *sbproto.CreateSubscription_Request) (*sbproto.CreateSubscription_Response, error) {
+	result, err := p.Impl.CreateSubscription(ctx, req.ContextId, req.Input)
+	if err != nil {
+		return &sbproto.CreateSubscription_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.CreateSubscription_Response{State: result}, nil
+}
+
+func (p *ProviderGRPCServer) ReadSubscription(ctx context.Context, req *sbproto.ReadSubscription_Request) (*sbproto.ReadSubscription_Response, error) {
+	result, err := p.Impl.ReadSubscription(ctx, req.ContextId, req.SubscriptionId)
+	if err != nil {
+		return &sbproto.ReadSubscription_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.ReadSubscription_Response{State: result}, nil
+}
+
+func (p *ProviderGRPCServer) UpdateSubscription(ctx context.Context, req *sbproto.UpdateSubscription_Request) (*sbproto.UpdateSubscription_Response, error) {
+	result, err := p.Impl.UpdateSubscription(ctx, req.ContextId, req.SubscriptionId, req.Input)
+	if err != nil {
+		return &sbproto.UpdateSubscription_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.UpdateSubscription_Response{State: result}, nil
+}
+
+func (p *ProviderGRPCServer) DeleteSubscription(ctx context.Context, req *sbproto.DeleteSubscription_Request) (*sbproto.DeleteSubscription_Response, error) {
+	err := p.Impl.DeleteSubscription(ctx, req.ContextId, req.SubscriptionId)
+	if err != nil {
+		return &sbproto.DeleteSubscription_Response{Diagnostics: diagnosticsToProto(err)}, nil
+	}
+	return &sbproto.DeleteSubscription_Response{}, nil
+}
+
+// RunnerProviderGRPCClient is handed to the plugin's Provider.Init, and calls back into
+// the runner over the broker stream negotiated during Init.
+type RunnerProviderGRPCClient struct {
+	client sbproto.RunnerClient
+}
+
+func (r *RunnerProviderGRPCClient) UserConfig() (map[string][]byte, error) {
+	resp, err := r.client.UserConfig(context.Background(), &sbproto.UserConfig_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Config, nil
+}
+
+func (r *RunnerProviderGRPCClient) GlobalConfig() (GlobalConfig, error) {
+	resp, err := r.client.GlobalConfig(context.Background(), &sbproto.GlobalConfig_Request{})
+	if err != nil {
+		return GlobalConfig{}, err
+	}
+	return GlobalConfig{
+		PublicIngestUri:  resp.PublicIngestUri,
+		PrivateIngestUri: resp.PrivateIngestUri,
+	}, nil
+}
+
+// RunnerProviderGRPCServer serves the runner's RunnerProvider to the plugin.
+type RunnerProviderGRPCServer struct {
+	Impl RunnerProviderV3
+}
+
+func (r *RunnerProviderGRPCServer) UserConfig(_ context.Context, _ *sbproto.UserConfig_Request) (*sbproto.UserConfig_Response, error) {
+	result, err := r.Impl.UserConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &sbproto.UserConfig_Response{Config: result}, nil
+}
+
+func (r *RunnerProviderGRPCServer) GlobalConfig(_ context.Context, _ *sbproto.GlobalConfig_Request) (*sbproto.GlobalConfig_Response, error) {
+	result, err := r.Impl.GlobalConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &sbproto.GlobalConfig_Response{
+		PublicIngestUri:  result.PublicIngestUri,
+		PrivateIngestUri: result.PrivateIngestUri,
+	}, nil
+}
+
+// diagnosticsToProto converts an error returned by a provider into wire diagnostics, keeping
+// Diagnostics intact and wrapping any other error in a single diagnostic.
+func diagnosticsToProto(err error) []*sbproto.Diagnostic {
+	diags := DiagnosticsFromError(err)
+	out := make([]*sbproto.Diagnostic, 0, len(diags))
+	for _, diag := range diags {
+		protoDiag := &sbproto.Diagnostic{
+			Summary:          diag.Summary,
+			Detail:           diag.Detail,
+			Attribute:        attributePathToProto(diag.Attribute),
+			Code:             diag.Code,
+			Kind:             sbproto.ErrorKind(diag.Kind),
+			RetryAfterMillis: diag.RetryAfter.Milliseconds(),
+		}
+		switch diag.Severity {
+		case DiagError:
+			protoDiag.Severity = sbproto.Diagnostic_ERROR
+		case DiagWarning:
+			protoDiag.Severity = sbproto.Diagnostic_WARNING
+		}
+		if diag.Subject != nil {
+			protoDiag.Subject = &sbproto.Range{
+				Filename: diag.Subject.Filename,
+				Start:    posToProto(diag.Subject.Start),
+				End:      posToProto(diag.Subject.End),
+			}
+		}
+		out = append(out, protoDiag)
+	}
+	return out
+}
+
+func diagnosticsFromProto(protoDiags []*sbproto.Diagnostic) Diagnostics {
+	out := make(Diagnostics, 0, len(protoDiags))
+	for _, protoDiag := range protoDiags {
+		diag := Diagnostic{
+			Summary:    protoDiag.Summary,
+			Detail:     protoDiag.Detail,
+			Attribute:  attributePathFromProto(protoDiag.Attribute),
+			Code:       protoDiag.Code,
+			Kind:       ErrorKind(protoDiag.Kind),
+			RetryAfter: time.Duration(protoDiag.RetryAfterMillis) * time.Millisecond,
+		}
+		switch protoDiag.Severity {
+		case sbproto.Diagnostic_ERROR:
+			diag.Severity = DiagError
+		case sbproto.Diagnostic_WARNING:
+			diag.Severity = DiagWarning
+		}
+		if protoDiag.Subject != nil {
+			diag.Subject = &hcl.Range{
+				Filename: protoDiag.Subject.Filename,
+				Start:    posFromProto(protoDiag.Subject.Start),
+				End:      posFromProto(protoDiag.Subject.End),
+			}
+		}
+		out = append(out, diag)
+	}
+	return out
+}
+
+func attributePathToProto(path cty.Path) *sbproto.AttributePath {
+	if len(path) == 0 {
+		return nil
+	}
+	out := &sbproto.AttributePath{}
+	for _, step := range redactPath(path) {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			out.Steps = append(out.Steps, &sbproto.AttributePath_Step{
+				Selector: &sbproto.AttributePath_Step_AttributeName{AttributeName: s.Name},
+			})
+		case cty.IndexStep:
+			if !s.Key.IsKnown() || s.Key.IsNull() {
+				continue
+			}
+			switch s.Key.Type() {
+			case cty.String:
+				out.Steps = append(out.Steps, &sbproto.AttributePath_Step{
+					Selector: &sbproto.AttributePath_Step_ElementKeyString{ElementKeyString: s.Key.AsString()},
+				})
+			case cty.Number:
+				key, _ := s.Key.AsBigFloat().Int64()
+				out.Steps = append(out.Steps, &sbproto.AttributePath_Step{
+					Selector: &sbproto.AttributePath_Step_ElementKeyInt{ElementKeyInt: key},
+				})
+			}
+		}
+	}
+	return out
+}
+
+func attributePathFromProto(protoPath *sbproto.AttributePath) cty.Path {
+	var path cty.Path
+	for _, step := range protoPath.GetSteps() {
+		switch s := step.Selector.(type) {
+		case *sbproto.AttributePath_Step_AttributeName:
+			path = path.GetAttr(s.AttributeName)
+		case *sbproto.AttributePath_Step_ElementKeyString:
+			path = path.Index(cty.StringVal(s.ElementKeyString))
+		case *sbproto.AttributePath_Step_ElementKeyInt:
+			path = path.IndexInt(int(s.ElementKeyInt))
+		}
+	}
+	return path
+}
+
+func posToProto(pos hcl.Pos) *sbproto.Range_Pos {
+	return &sbproto.Range_Pos{
+		Line:   int64(pos.Line),
+		Column: int64(pos.Column),
+		Byte:   int64(pos.Byte),
+	}
+}
+
+func posFromProto(pos *sbproto.Range_Pos) hcl.Pos {
+	return hcl.Pos{
+		Line:   int(pos.GetLine()),
+		Column: int(pos.GetColumn()),
+		Byte:   int(pos.GetByte()),
+	}
+}
+
+func metadataToProto(metadata Metadata) *sbproto.Metadata {
+	return &sbproto.Metadata{
+		Description:         metadata.Description,
+		MarkdownDescription: metadata.MarkdownDescription,
+		Examples:            metadata.Examples,
+		Deprecated:          metadata.Deprecated,
+		DeprecationMessage:  metadata.DeprecationMessage,
+	}
+}
+
+func metadataFromProto(metadata *sbproto.Metadata) Metadata {
+	return Metadata{
+		Description:         metadata.GetDescription(),
+		MarkdownDescription: metadata.GetMarkdownDescription(),
+		Examples:            metadata.GetExamples(),
+		Deprecated:          metadata.GetDeprecated(),
+		DeprecationMessage:  metadata.GetDeprecationMessage(),
+	}
+}
+
+// schemaJSON is the wire representation of a Schema in the gRPC protocol. Exactly one
+// field is set, which tells the receiving side which Schema implementation to rebuild.
+type schemaJSON struct {
+	Object map[string]*schemaJSON `json:"object"`
+	Attr   *AttrSchema            `json:"attr,omitempty"`
+	Block  *blockSchemaJSON       `json:"block,omitempty"`
+	//Constraints are the constraints of an ObjectSchema, and are only set alongside Object
+	Constraints []Constraint `json:"constraints,omitempty"`
+}
+
+type blockSchemaJSON struct {
+	Metadata
+	Name     string      `json:"name"`
+	Required bool        `json:"required"`
+	Nested   *schemaJSON `json:"nested"`
+	Mode     string      `json:"mode,omitempty"`
+	Labels   []string    `json:"labels,omitempty"`
+	MinItems int         `json:"min_items,omitempty"`
+	MaxItems int         `json:"max_items,omitempty"`
+}
+
+func marshalObjectSchema(schema ObjectSchema) ([]byte, error) {
+	wire, err := toSchemaJSON(&schema)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(wire)
+}
+
+func unmarshalObjectSchema(data []byte) (ObjectSchema, error) {
+	var wire schemaJSON
+	err := json.Unmarshal(data, &wire)
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	schema, err := fromSchemaJSON(&wire)
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	objectSchema, ok := schema.(*ObjectSchema)
+	if !ok {
+		return ObjectSchema{}, errors.New("root schema must be an object")
+	}
+	return *objectSchema, nil
+}
+
+func unmarshalType(data []byte) (Type, error) {
+	var result Type
+	err := json.Unmarshal(data, &result)
+	if err != nil {
+		return Type{}, err
+	}
+	return result, nil
+}
+
+func toSchemaJSON(schema Schema) (*schemaJSON, error) {
+	switch s := schema.(type) {
+	case *ObjectSchema:
+		object := make(map[string]*schemaJSON)
+		for k, v := range s.Attributes {
+			nested, err := toSchemaJSON(v)
+			if err != nil {
+				return nil, err
+			}
+			object[k] = nested
+		}
+		return &schemaJSON{Object: object, Constraints: s.Constraints}, nil
+	case *AttrSchema:
+		return &schemaJSON{Attr: s}, nil
+	case *BlockSchema:
+		nested, err := toSchemaJSON(s.Nested)
+		if err != nil {
+			return nil, err
+		}
+		return &schemaJSON{Block: &blockSchemaJSON{
+			Metadata: s.Metadata,
+			Name:     s.Name,
+			Required: s.Required,
+			Nested:   nested,
+			Mode:     s.Mode,
+			Labels:   s.Labels,
+			MinItems: s.MinItems,
+			MaxItems: s.MaxItems,
+		}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported schema type %T", schema)
+	}
+}
+
+func fromSchemaJSON(wire *schemaJSON) (Schema, error) {
+	switch {
+	case wire == nil:
+		return nil, errors.New("missing schema")
+	case wire.Object != nil:
+		object := ObjectSchema{
+			Attributes:  make(map[string]Schema, len(wire.Object)),
+			Constraints: wire.Constraints,
+		}
+		for k, v := range wire.Object {
+			nested, err := fromSchemaJSON(v)
+			if err != nil {
+				return nil, err
+			}
+			object.Attributes[k] = nested
+		}
+		return &object, nil
+	case wire.Attr != nil:
+		return wire.Attr, nil
+	case wire.Block != nil:
+		nested, err := fromSchemaJSON(wire.Block.Nested)
+		if err != nil {
+			return nil, err
+		}
+		return &BlockSchema{
+			Metadata: wire.Block.Metadata,
+			Name:     wire.Block.Name,
+			Required: wire.Block.Required,
+			Nested:   nested,
+			Mode:     wire.Block.Mode,
+			Labels:   wire.Block.Labels,
+			MinItems: wire.Block.MinItems,
+			MaxItems: wire.Block.MaxItems,
+		}, nil
+	default:
+		return nil, errors.New("schema has no kind set")
+	}
+}
@@ -0,0 +1,240 @@
+package sbsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// testProvider is a ProviderV3 whose answers are set by each test, and which records what the
+// runner sent it.
+type testProvider struct {
+	config       ProviderConfig
+	schema       ObjectSchema
+	outputType   Type
+	names        []string
+	metadata     Metadata
+	err          error
+	userConfig   map[string][]byte
+	globalConfig GlobalConfig
+	lastInput    []byte
+	//sensitivePaths is returned by ActionEvaluate along with its output
+	sensitivePaths []cty.Path
+	//runners holds the RunnerProvider passed to every Init
+	runners []RunnerProviderV3
+	//evaluate, when set, is called by ActionEvaluate instead of answering straight away
+	evaluate func(ctx context.Context) ([]byte, error)
+}
+
+func (p *testProvider) Init(_ context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error) {
+	p.runners = append(p.runners, runnerProvider)
+	p.userConfig, _ = runnerProvider.UserConfig()
+	p.globalConfig, _ = runnerProvider.GlobalConfig()
+	return p.config, p.err
+}
+
+func (p *testProvider) InitSchema(_ context.Context) (ObjectSchema, error) {
+	return p.schema, p.err
+}
+
+func (p *testProvider) ActionNames(_ context.Context) ([]string, error) {
+	return p.names, p.err
+}
+
+func (p *testProvider) ActionEvaluate(ctx context.Context, contextId string, name string, input []byte) (ActionOutput, error) {
+	p.lastInput = input
+	if p.evaluate != nil {
+		output, err := p.evaluate(ctx)
+		return ActionOutput{Value: output}, err
+	}
+	return ActionOutput{Value: []byte(contextId + "/" + name), SensitivePaths: p.sensitivePaths}, p.err
+}
+
+func (p *testProvider) ActionConfigurationSchema(_ context.Context, _ string) (ObjectSchema, error) {
+	return p.schema, p.err
+}
+
+func (p *testProvider) ActionOutputType(_ context.Context, _ string) (Type, error) {
+	return p.outputType, p.err
+}
+
+func (p *testProvider) ActionMetadata(_ context.Context, _ string) (Metadata, error) {
+	return p.metadata, p.err
+}
+
+func (p *testProvider) TriggerKeyNames(_ context.Context) ([]string, error) {
+	return p.names, p.err
+}
+
+func (p *testProvider) TriggerConfigurationSchema(_ context.Context) (ObjectSchema, error) {
+	return p.schema, p.err
+}
+
+func (p *testProvider) MapPayloadToTriggerKey(_ context.Context, payload []byte) (string, error) {
+	return string(payload), p.err
+}
+
+func (p *testProvider) TriggerOutputType(_ context.Context, _ string) (Type, error) {
+	return p.outputType, p.err
+}
+
+func (p *testProvider) TriggerMetadata(_ context.Context, _ string) (Metadata, error) {
+	return p.metadata, p.err
+}
+
+func (p *testProvider) CreateSubscription(_ context.Context, contextId string, input []byte) ([]byte, error) {
+	p.lastInput = input
+	return []byte(contextId), p.err
+}
+
+func (p *testProvider) ReadSubscription(_ context.Context, contextId string, subscriptionId string) ([]byte, error) {
+	return []byte(contextId + "/" + subscriptionId), p.err
+}
+
+func (p *testProvider) UpdateSubscription(_ context.Context, contextId string, subscriptionId string, input []byte) ([]byte, error) {
+	p.lastInput = input
+	return []byte(contextId + "/" + subscriptionId), p.err
+}
+
+func (p *testProvider) DeleteSubscription(_ context.Context, _ string, _ string) error {
+	return p.err
+}
+
+type testRunnerProvider struct {
+	userConfig   map[string][]byte
+	globalConfig GlobalConfig
+}
+
+func (r *testRunnerProvider) UserConfig() map[string][]byte {
+	return r.userConfig
+}
+
+func (r *testRunnerProvider) GlobalConfig() GlobalConfig {
+	return r.globalConfig
+}
+
+func dispenseGRPC(t *testing.T, impl ProviderV3) ProviderV3 {
+	t.Helper()
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		"provider": &ProviderPlugin{Impl: impl},
+	})
+	t.Cleanup(func() { client.Close() })
+	raw, err := client.Dispense("provider")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw.(ProviderV3)
+}
+
+func TestGRPCRoundTrip(t *testing.T) {
+	impl := &testProvider{
+		config: ProviderConfig{SubscriptionsRegisteredTogether: true},
+		schema: ObjectSchema{Attributes: map[string]Schema{
+			"name": &AttrSchema{Name: "name", Required: true, Type: String},
+			"header": &BlockSchema{Name: "header", Nested: &ObjectSchema{Attributes: map[string]Schema{
+				"value": &AttrSchema{Name: "value", Type: List(Number)},
+			}}},
+		}},
+		outputType: Object(map[string]Type{"id": String, "tags": Map(String)}),
+		names:      []string{"create_user", "delete_user"},
+		metadata:   Metadata{Description: "Creates a user", Examples: []string{`name = "a"`}, Deprecated: true},
+	}
+	provider := dispenseGRPC(t, impl)
+	ctx := context.Background()
+
+	runner := &testRunnerProvider{
+		userConfig:   map[string][]byte{"default": []byte(`{"name":"a"}`)},
+		globalConfig: GlobalConfig{PublicIngestUri: "https://public", PrivateIngestUri: "https://private"},
+	}
+	config, err := provider.Init(ctx, AdaptRunnerProvider(runner))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config != impl.config {
+		t.Errorf("got config %+v, want %+v", config, impl.config)
+	}
+	if !reflect.DeepEqual(impl.userConfig, runner.userConfig) || impl.globalConfig != runner.globalConfig {
+		t.Errorf("provider got user config %q and global config %+v", impl.userConfig, impl.globalConfig)
+	}
+
+	schema, err := provider.ActionConfigurationSchema(ctx, "create_user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(schema, impl.schema) {
+		t.Errorf("got schema %#v, want %#v", schema, impl.schema)
+	}
+	outputType, err := provider.ActionOutputType(ctx, "create_user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(outputType, impl.outputType) {
+		t.Errorf("got output type %s, want %s", typeJSON(t, outputType), typeJSON(t, impl.outputType))
+	}
+	names, err := provider.ActionNames(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, impl.names) {
+		t.Errorf("got names %q, want %q", names, impl.names)
+	}
+
+	metadata, err := provider.ActionMetadata(ctx, "create_user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(metadata, impl.metadata) {
+		t.Errorf("got metadata %+v, want %+v", metadata, impl.metadata)
+	}
+
+	output, err := provider.ActionEvaluate(ctx, "ctx", "create_user", []byte(`{"name":"a"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(output.Value) != "ctx/create_user" || string(impl.lastInput) != `{"name":"a"}` {
+		t.Errorf("got output %q for input %q", output.Value, impl.lastInput)
+	}
+	key, err := provider.MapPayloadToTriggerKey(ctx, []byte("user_created"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "user_created" {
+		t.Errorf("got trigger key %q", key)
+	}
+	state, err := provider.UpdateSubscription(ctx, "ctx", "sub", []byte("input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(state) != "ctx/sub" || string(impl.lastInput) != "input" {
+		t.Errorf("got state %q for input %q", state, impl.lastInput)
+	}
+	if err := provider.DeleteSubscription(ctx, "ctx", "sub"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGRPCReturnsProviderErrors(t *testing.T) {
+	provider := dispenseGRPC(t, &testProvider{err: errors.New("vendor is down")})
+	_, err := provider.ActionEvaluate(context.Background(), "ctx", "create_user", nil)
+	if err == nil || !strings.Contains(err.Error(), "vendor is down") {
+		t.Fatalf("got error %v, want the provider's error", err)
+	}
+	if err := provider.DeleteSubscription(context.Background(), "ctx", "sub"); err == nil {
+		t.Fatal("got no error from DeleteSubscription")
+	}
+}
+
+func typeJSON(t *testing.T, typ Type) string {
+	t.Helper()
+	data, err := json.Marshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,712 @@
+package sbsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+const (
+	//JSON_SCHEMA_DIALECT is the $schema of the documents produced by ToJSONSchema
+	JSON_SCHEMA_DIALECT = "https://json-schema.org/draft/2020-12/schema"
+
+	JSON_SCHEMA_STRING  = "string"
+	JSON_SCHEMA_NUMBER  = "number"
+	JSON_SCHEMA_INTEGER = "integer"
+	JSON_SCHEMA_BOOLEAN = "boolean"
+	JSON_SCHEMA_OBJECT  = "object"
+	JSON_SCHEMA_ARRAY   = "array"
+	JSON_SCHEMA_NULL    = "null"
+)
+
+// JSONSchema is a JSON Schema document, or a subschema of one. It has the keywords of draft
+// 2020-12 that ToJSONSchema produces and TypeFromJSONSchema understands, along with the draft-07
+// and OpenAPI 3.0 spellings of some of them, so that schemas published by vendors can be imported.
+type JSONSchema struct {
+	Schema string                 `json:"$schema,omitempty"`
+	Ref    string                 `json:"$ref,omitempty"`
+	Defs   map[string]*JSONSchema `json:"$defs,omitempty"`
+	//Definitions is the draft-07 spelling of Defs
+	Definitions map[string]*JSONSchema `json:"definitions,omitempty"`
+
+	Type        JSONSchemaTypes   `json:"type,omitempty"`
+	Title       string            `json:"title,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Deprecated  bool              `json:"deprecated,omitempty"`
+	WriteOnly   bool              `json:"writeOnly,omitempty"`
+	Default     json.RawMessage   `json:"default,omitempty"`
+	Examples    []json.RawMessage `json:"examples,omitempty"`
+	Enum        []json.RawMessage `json:"enum,omitempty"`
+	Const       json.RawMessage   `json:"const,omitempty"`
+	//Nullable is the OpenAPI 3.0 spelling of adding "null" to Type
+	Nullable bool `json:"nullable,omitempty"`
+
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+	MinProperties        *int                   `json:"minProperties,omitempty"`
+	MaxProperties        *int                   `json:"maxProperties,omitempty"`
+	DependentRequired    map[string][]string    `json:"dependentRequired,omitempty"`
+	DependentSchemas     map[string]*JSONSchema `json:"dependentSchemas,omitempty"`
+
+	//Items is the schema of every element of an array after PrefixItems. The draft-07 array
+	//form of items is read into PrefixItems.
+	Items       *JSONSchema   `json:"items,omitempty"`
+	PrefixItems []*JSONSchema `json:"prefixItems,omitempty"`
+	UniqueItems bool          `json:"uniqueItems,omitempty"`
+	MinItems    *int          `json:"minItems,omitempty"`
+	MaxItems    *int          `json:"maxItems,omitempty"`
+
+	Pattern   string   `json:"pattern,omitempty"`
+	Format    string   `json:"format,omitempty"`
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Minimum   *float64 `json:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty"`
+
+	AllOf []*JSONSchema `json:"allOf,omitempty"`
+	AnyOf []*JSONSchema `json:"anyOf,omitempty"`
+	OneOf []*JSONSchema `json:"oneOf,omitempty"`
+	Not   *JSONSchema   `json:"not,omitempty"`
+
+	//never is the boolean schema false, which no value is valid against. An empty JSONSchema is
+	//the boolean schema true.
+	never bool
+}
+
+// JSONSchemaTypes is the "type" keyword, which is a single type name or an array of them.
+type JSONSchemaTypes []string
+
+func (t JSONSchemaTypes) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+func (t *JSONSchemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = JSONSchemaTypes{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+type jsonSchemaAlias JSONSchema
+
+func (s *JSONSchema) MarshalJSON() ([]byte, error) {
+	if s.never {
+		return []byte("false"), nil
+	}
+	return json.Marshal((*jsonSchemaAlias)(s))
+}
+
+func (s *JSONSchema) UnmarshalJSON(data []byte) error {
+	switch string(bytes.TrimSpace(data)) {
+	case "true":
+		*s = JSONSchema{}
+		return nil
+	case "false":
+		*s = JSONSchema{never: true}
+		return nil
+	}
+	aux := struct {
+		*jsonSchemaAlias
+		Items json.RawMessage `json:"items,omitempty"`
+	}{jsonSchemaAlias: (*jsonSchemaAlias)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	items := bytes.TrimSpace(aux.Items)
+	switch {
+	case len(items) == 0:
+	case items[0] == '[':
+		return json.Unmarshal(items, &s.PrefixItems)
+	default:
+		s.Items = &JSONSchema{}
+		return json.Unmarshal(items, s.Items)
+	}
+	return nil
+}
+
+// ToJSONSchema describes the JSON form of configuration conforming to s as a draft 2020-12 JSON
+// Schema, for tools such as form builders that don't understand hcl. Blocks are properties
+// holding an object, or an array or map of objects, and optional attributes and blocks are
+// omitted rather than null. Descriptions, validators and constraints are included.
+func (s *ObjectSchema) ToJSONSchema() (*JSONSchema, error) {
+	out, err := schemaToJSONSchema(s)
+	if err != nil {
+		return nil, err
+	}
+	out.Schema = JSON_SCHEMA_DIALECT
+	return out, nil
+}
+
+// ToJSONSchema describes the JSON form of values of t as a draft 2020-12 JSON Schema. Optional
+// object attributes may be omitted, attributes with defaults have a default, and sensitive
+// values are writeOnly. TypeFromJSONSchema is the reverse conversion.
+func (t *Type) ToJSONSchema() (*JSONSchema, error) {
+	out, err := typeToJSONSchema(*t)
+	if err != nil {
+		return nil, err
+	}
+	out.Schema = JSON_SCHEMA_DIALECT
+	return out, nil
+}
+
+func schemaToJSONSchema(schema Schema) (*JSONSchema, error) {
+	switch s := schema.(type) {
+	case *ObjectSchema:
+		out := &JSONSchema{
+			Type:                 JSONSchemaTypes{JSON_SCHEMA_OBJECT},
+			Properties:           map[string]*JSONSchema{},
+			AdditionalProperties: &JSONSchema{never: true},
+		}
+		for k, v := range s.Attributes {
+			prop, err := schemaToJSONSchema(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			out.Properties[k] = prop
+			switch v := v.(type) {
+			case *AttrSchema:
+				if v.Required {
+					out.Required = append(out.Required, k)
+				}
+			case *BlockSchema:
+				if v.Required {
+					out.Required = append(out.Required, k)
+				}
+			}
+		}
+		sort.Strings(out.Required)
+		for _, constraint := range s.Constraints {
+			out.AllOf = append(out.AllOf, constraintToJSONSchema(constraint))
+		}
+		return out, nil
+	case *AttrSchema:
+		out, err := typeToJSONSchema(s.Type)
+		if err != nil {
+			return nil, err
+		}
+		applyMetadata(out, s.Metadata)
+		out.WriteOnly = out.WriteOnly || s.Sensitive
+		for _, validator := range s.Validators {
+			applyValidator(out, validator, s.Type)
+		}
+		return out, nil
+	case *BlockSchema:
+		out, err := schemaToJSONSchema(s.Nested)
+		if err != nil {
+			return nil, err
+		}
+		switch s.Mode {
+		case BLOCK_MODE_MAP:
+			for range s.Labels {
+				out = &JSONSchema{
+					Type:                 JSONSchemaTypes{JSON_SCHEMA_OBJECT},
+					AdditionalProperties: out,
+				}
+			}
+			if s.Required {
+				minProperties := 1
+				out.MinProperties = &minProperties
+			}
+		case BLOCK_MODE_LIST, BLOCK_MODE_SET:
+			out = blockLabelsToJSONSchema(out, s.Labels)
+			out = &JSONSchema{
+				Type:        JSONSchemaTypes{JSON_SCHEMA_ARRAY},
+				Items:       out,
+				UniqueItems: s.Mode == BLOCK_MODE_SET,
+			}
+			minItems := s.MinItems
+			if s.Required && minItems == 0 {
+				minItems = 1
+			}
+			if minItems > 0 {
+				out.MinItems = &minItems
+			}
+			if s.MaxItems > 0 {
+				maxItems := s.MaxItems
+				out.MaxItems = &maxItems
+			}
+		default:
+			out = blockLabelsToJSONSchema(out, s.Labels)
+		}
+		applyMetadata(out, s.Metadata)
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported schema %T", schema)
+	}
+}
+
+// blockLabelsToJSONSchema adds the labels of a block that isn't in BLOCK_MODE_MAP to the schema
+// of its body, as the required string attributes they are decoded into.
+func blockLabelsToJSONSchema(out *JSONSchema, labels []string) *JSONSchema {
+	if len(labels) == 0 {
+		return out
+	}
+	if out.Properties == nil {
+		out.Properties = map[string]*JSONSchema{}
+	}
+	for _, label := range labels {
+		out.Properties[label] = &JSONSchema{Type: JSONSchemaTypes{JSON_SCHEMA_STRING}}
+		out.Required = append(out.Required, label)
+	}
+	sort.Strings(out.Required)
+	return out
+}
+
+func constraintToJSONSchema(constraint Constraint) *JSONSchema {
+	requireEach := func(attrs []string) []*JSONSchema {
+		out := make([]*JSONSchema, 0, len(attrs))
+		for _, attr := range attrs {
+			out = append(out, &JSONSchema{Required: []string{attr}})
+		}
+		return out
+	}
+	switch constraint.Kind {
+	case CONSTRAINT_EXACTLY_ONE_OF:
+		return &JSONSchema{OneOf: requireEach(constraint.With)}
+	case CONSTRAINT_AT_LEAST_ONE_OF:
+		return &JSONSchema{AnyOf: requireEach(constraint.With)}
+	case CONSTRAINT_CONFLICTS_WITH:
+		return &JSONSchema{DependentSchemas: map[string]*JSONSchema{
+			constraint.Attribute: {Not: &JSONSchema{AnyOf: requireEach(constraint.With)}},
+		}}
+	case CONSTRAINT_REQUIRED_WITH:
+		return &JSONSchema{DependentRequired: map[string][]string{
+			constraint.Attribute: constraint.With,
+		}}
+	default:
+		return &JSONSchema{}
+	}
+}
+
+func typeToJSONSchema(t Type) (*JSONSchema, error) {
+	out := &JSONSchema{}
+	switch t.TypeName {
+	case STRING_TYPE:
+		out.Type = JSONSchemaTypes{JSON_SCHEMA_STRING}
+	case NUMBER_TYPE:
+		out.Type = JSONSchemaTypes{JSON_SCHEMA_NUMBER}
+	case BOOLEAN_TYPE:
+		out.Type = JSONSchemaTypes{JSON_SCHEMA_BOOLEAN}
+	case DYNAMIC_TYPE:
+	case OBJECT_TYPE:
+		out.Type = JSONSchemaTypes{JSON_SCHEMA_OBJECT}
+		out.Properties = map[string]*JSONSchema{}
+		out.AdditionalProperties = &JSONSchema{never: true}
+		optional := t.optionalAttrs()
+		for name, attrType := range t.nestedValues() {
+			prop, err := typeToJSONSchema(attrType)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			if t.Defaults != nil && !attrType.ToCty().HasDynamicTypes() {
+				//cty JSON is plain JSON for types without dynamic parts
+				if data, ok := (*t.Defaults)[name]; ok {
+					prop.Default = data
+				}
+			}
+			out.Properties[name] = prop
+			if !containsString(optional, name) {
+				out.Required = append(out.Required, name)
+			}
+		}
+		sort.Strings(out.Required)
+	case MAP_TYPE:
+		elementType, err := typeToJSONSchema(t.elementType())
+		if err != nil {
+			return nil, err
+		}
+		out.Type = JSONSchemaTypes{JSON_SCHEMA_OBJECT}
+		out.AdditionalProperties = elementType
+	case LIST_TYPE, SET_TYPE:
+		elementType, err := typeToJSONSchema(t.elementType())
+		if err != nil {
+			return nil, err
+		}
+		out.Type = JSONSchemaTypes{JSON_SCHEMA_ARRAY}
+		out.Items = elementType
+		out.UniqueItems = t.TypeName == SET_TYPE
+	case TUPLE_TYPE:
+		out.Type = JSONSchemaTypes{JSON_SCHEMA_ARRAY}
+		out.PrefixItems = []*JSONSchema{}
+		if t.ElementTypes != nil {
+			for i, v := range *t.ElementTypes {
+				el, err := typeToJSONSchema(v)
+				if err != nil {
+					return nil, fmt.Errorf("[%d]: %w", i, err)
+				}
+				out.PrefixItems = append(out.PrefixItems, el)
+			}
+		}
+		length := len(out.PrefixItems)
+		out.Items = &JSONSchema{never: true}
+		out.MinItems = &length
+	default:
+		return nil, fmt.Errorf("unknown type name %q", t.TypeName)
+	}
+	applyMetadata(out, t.Metadata)
+	out.WriteOnly = t.Sensitive
+	return out, nil
+}
+
+func applyMetadata(out *JSONSchema, metadata Metadata) {
+	switch {
+	case metadata.Description != "":
+		out.Description = metadata.Description
+	case metadata.MarkdownDescription != "":
+		out.Description = metadata.MarkdownDescription
+	}
+	out.Deprecated = out.Deprecated || metadata.Deprecated
+}
+
+// applyValidator adds the JSON Schema keywords equivalent to validator, on an attribute of type t.
+func applyValidator(out *JSONSchema, validator Validator, t Type) {
+	toInt := func(f *float64) *int {
+		if f == nil {
+			return nil
+		}
+		i := int(*f)
+		return &i
+	}
+	switch validator.Kind {
+	case VALIDATOR_ENUM:
+		for _, v := range validator.Values {
+			switch t.TypeName {
+			case NUMBER_TYPE, BOOLEAN_TYPE:
+				if json.Valid([]byte(v)) {
+					out.Enum = append(out.Enum, json.RawMessage(v))
+				}
+			default:
+				data, _ := json.Marshal(v)
+				out.Enum = append(out.Enum, data)
+			}
+		}
+	case VALIDATOR_REGEX:
+		out.Pattern = validator.Pattern
+	case VALIDATOR_RANGE:
+		out.Minimum = validator.Min
+		out.Maximum = validator.Max
+	case VALIDATOR_LENGTH:
+		switch t.TypeName {
+		case STRING_TYPE:
+			out.MinLength, out.MaxLength = toInt(validator.Min), toInt(validator.Max)
+		case MAP_TYPE, OBJECT_TYPE:
+			out.MinProperties, out.MaxProperties = toInt(validator.Min), toInt(validator.Max)
+		default:
+			out.MinItems, out.MaxItems = toInt(validator.Min), toInt(validator.Max)
+		}
+	case VALIDATOR_FORMAT:
+		out.Format = validator.Format
+		if validator.Format == FORMAT_URL {
+			out.Format = "uri"
+		}
+	}
+}
+
+// TypeFromJSONSchema derives a Type from a JSON Schema, such as one published by a vendor for
+// its API. $refs are resolved against the $defs and definitions of schema, and against refs,
+// which maps other references, such as "#/components/schemas/Pet" in an OpenAPI document, to
+// their schemas. Keywords that a Type can't express, such as validation keywords, are ignored,
+// and schemas that don't describe a single type, such as a oneOf of different types or a
+// recursive $ref, become Dynamic. Defaults that don't conform to their attribute's type are
+// ignored. An error is returned for $refs that can't be resolved.
+func TypeFromJSONSchema(schema *JSONSchema, refs map[string]*JSONSchema) (Type, error) {
+	all := make(map[string]*JSONSchema, len(refs))
+	for k, v := range refs {
+		all[k] = v
+	}
+	for k, v := range schema.Definitions {
+		all["#/definitions/"+k] = v
+	}
+	for k, v := range schema.Defs {
+		all["#/$defs/"+k] = v
+	}
+	importer := jsonSchemaImporter{refs: all, visiting: map[string]bool{}}
+	return importer.toType(schema)
+}
+
+type jsonSchemaImporter struct {
+	refs     map[string]*JSONSchema
+	visiting map[string]bool
+}
+
+func (i jsonSchemaImporter) toType(s *JSONSchema) (Type, error) {
+	if s == nil {
+		return Dynamic, nil
+	}
+	if s.never {
+		return Invalid, fmt.Errorf("the schema false permits no values")
+	}
+	out, err := i.baseType(s)
+	if err != nil {
+		return Invalid, err
+	}
+	if s.Description != "" {
+		out.Description = s.Description
+	}
+	if s.Deprecated {
+		out.Deprecated = true
+	}
+	for _, example := range s.Examples {
+		out.Examples = append(out.Examples, string(example))
+	}
+	if s.WriteOnly || s.Format == "password" {
+		out.Sensitive = true
+	}
+	return out, nil
+}
+
+func (i jsonSchemaImporter) baseType(s *JSONSchema) (Type, error) {
+	if s.Ref != "" {
+		if i.visiting[s.Ref] {
+			return Dynamic, nil
+		}
+		target, ok := i.refs[s.Ref]
+		if !ok {
+			return Invalid, fmt.Errorf("cannot resolve $ref %q", s.Ref)
+		}
+		i.visiting[s.Ref] = true
+		defer delete(i.visiting, s.Ref)
+		return i.toType(target)
+	}
+	if len(s.AllOf) > 0 {
+		return i.allOfType(s)
+	}
+	if alternatives := append(append([]*JSONSchema{}, s.OneOf...), s.AnyOf...); len(alternatives) > 0 {
+		return i.alternativesType(alternatives)
+	}
+
+	var types []string
+	for _, name := range s.Type {
+		if name != JSON_SCHEMA_NULL {
+			types = append(types, name)
+		}
+	}
+	if len(s.Type) == 0 {
+		types = inferJSONSchemaTypes(s)
+	}
+	if len(types) != 1 {
+		return Dynamic, nil
+	}
+	switch types[0] {
+	case JSON_SCHEMA_STRING:
+		return String, nil
+	case JSON_SCHEMA_NUMBER, JSON_SCHEMA_INTEGER:
+		return Number, nil
+	case JSON_SCHEMA_BOOLEAN:
+		return Bool, nil
+	case JSON_SCHEMA_ARRAY:
+		if len(s.PrefixItems) > 0 {
+			elementTypes := make([]Type, 0, len(s.PrefixItems))
+			for n, item := range s.PrefixItems {
+				el, err := i.toType(item)
+				if err != nil {
+					return Invalid, fmt.Errorf("prefixItems[%d]: %w", n, err)
+				}
+				elementTypes = append(elementTypes, el)
+			}
+			return Tuple(elementTypes...), nil
+		}
+		elementType, err := i.toType(s.Items)
+		if err != nil {
+			return Invalid, fmt.Errorf("items: %w", err)
+		}
+		if s.UniqueItems {
+			return Set(elementType), nil
+		}
+		return List(elementType), nil
+	case JSON_SCHEMA_OBJECT:
+		if len(s.Properties) == 0 {
+			if s.AdditionalProperties == nil || s.AdditionalProperties.never {
+				return Dynamic, nil
+			}
+			elementType, err := i.toType(s.AdditionalProperties)
+			if err != nil {
+				return Invalid, fmt.Errorf("additionalProperties: %w", err)
+			}
+			return Map(elementType), nil
+		}
+		return i.objectType(s.Properties, s.Required)
+	default:
+		return Dynamic, nil
+	}
+}
+
+func (i jsonSchemaImporter) objectType(properties map[string]*JSONSchema, required []string) (Type, error) {
+	attrs := make(map[string]Type, len(properties))
+	defaults := map[string]cty.Value{}
+	for name, prop := range properties {
+		attr, err := i.toType(prop)
+		if err != nil {
+			return Invalid, fmt.Errorf("%s: %w", name, err)
+		}
+		attrs[name] = attr
+		if len(prop.Default) > 0 && !attr.ToCty().HasDynamicTypes() {
+			if val, err := ctyjson.Unmarshal(prop.Default, attr.valueType()); err == nil {
+				defaults[name] = val
+			}
+		}
+	}
+	var optional []string
+	for name := range attrs {
+		if _, hasDefault := defaults[name]; !containsString(required, name) && !hasDefault {
+			optional = append(optional, name)
+		}
+	}
+	sort.Strings(optional)
+	out := Object(attrs)
+	if len(optional) > 0 {
+		out.OptionalAttrs = &optional
+	}
+	if len(defaults) > 0 {
+		err := out.setDefaults(defaults)
+		if err != nil {
+			return Invalid, err
+		}
+	}
+	return out, nil
+}
+
+// allOfType merges the schemas of allOf, which is how JSON Schemas usually extend an object.
+// The merge is only possible when every schema is an object, and otherwise the type is Dynamic.
+func (i jsonSchemaImporter) allOfType(s *JSONSchema) (Type, error) {
+	schemas := append([]*JSONSchema{}, s.AllOf...)
+	rest := *s
+	rest.AllOf = nil
+	if len(rest.Type) > 0 || len(rest.Properties) > 0 || rest.Ref != "" {
+		schemas = append(schemas, &rest)
+	}
+	if len(schemas) == 1 {
+		return i.toType(schemas[0])
+	}
+	attrs := map[string]Type{}
+	required := map[string]bool{}
+	defaults := map[string]json.RawMessage{}
+	for _, schema := range schemas {
+		t, err := i.toType(schema)
+		if err != nil {
+			return Invalid, err
+		}
+		if t.TypeName != OBJECT_TYPE {
+			return Dynamic, nil
+		}
+		optional := t.optionalAttrs()
+		for name, attrType := range t.nestedValues() {
+			attrs[name] = attrType
+			if !containsString(optional, name) {
+				required[name] = true
+			}
+		}
+		if t.Defaults != nil {
+			for name, data := range *t.Defaults {
+				defaults[name] = data
+			}
+		}
+	}
+	out := Object(attrs)
+	var optional []string
+	for name := range attrs {
+		if _, hasDefault := defaults[name]; !required[name] && !hasDefault {
+			optional = append(optional, name)
+		}
+	}
+	if len(optional) > 0 {
+		sort.Strings(optional)
+		out.OptionalAttrs = &optional
+	}
+	if len(defaults) > 0 {
+		out.Defaults = &defaults
+	}
+	return out, nil
+}
+
+// alternativesType is the type of a oneOf or anyOf. Alternatives that only permit null are
+// ignored, since any type may be null, and the remaining alternatives must all have the same
+// type for the result not to be Dynamic.
+func (i jsonSchemaImporter) alternativesType(alternatives []*JSONSchema) (Type, error) {
+	var out *Type
+	for _, alternative := range alternatives {
+		if len(alternative.Type) == 1 && alternative.Type[0] == JSON_SCHEMA_NULL {
+			continue
+		}
+		t, err := i.toType(alternative)
+		if err != nil {
+			return Invalid, err
+		}
+		if out == nil {
+			out = &t
+			continue
+		}
+		if !out.Equals(t) {
+			return Dynamic, nil
+		}
+	}
+	if out == nil {
+		return Dynamic, nil
+	}
+	return *out, nil
+}
+
+// inferJSONSchemaTypes guesses the types permitted by a schema without a "type" keyword from
+// its other keywords.
+func inferJSONSchemaTypes(s *JSONSchema) []string {
+	switch {
+	case len(s.Properties) > 0 || s.AdditionalProperties != nil:
+		return []string{JSON_SCHEMA_OBJECT}
+	case s.Items != nil || len(s.PrefixItems) > 0:
+		return []string{JSON_SCHEMA_ARRAY}
+	}
+	values := s.Enum
+	if len(s.Const) > 0 {
+		values = append(values, s.Const)
+	}
+	seen := map[string]bool{}
+	var out []string
+	for _, v := range values {
+		name := jsonValueType(v)
+		if name != "" && name != JSON_SCHEMA_NULL && !seen[name] {
+			seen[name] = true
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// jsonValueType returns the JSON Schema type name of a JSON value.
+func jsonValueType(data json.RawMessage) string {
+	trimmed := strings.TrimSpace(string(data))
+	switch {
+	case trimmed == "":
+		return ""
+	case trimmed == "null":
+		return JSON_SCHEMA_NULL
+	case trimmed == "true" || trimmed == "false":
+		return JSON_SCHEMA_BOOLEAN
+	case trimmed[0] == '"':
+		return JSON_SCHEMA_STRING
+	case trimmed[0] == '{':
+		return JSON_SCHEMA_OBJECT
+	case trimmed[0] == '[':
+		return JSON_SCHEMA_ARRAY
+	}
+	if _, err := strconv.ParseFloat(trimmed, 64); err == nil {
+		return JSON_SCHEMA_NUMBER
+	}
+	return ""
+}
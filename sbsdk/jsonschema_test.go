@@ -0,0 +1,223 @@
+package sbsdk
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func jsonSchemaString(t *testing.T, s *JSONSchema) string {
+	t.Helper()
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestObjectSchemaToJSONSchema(t *testing.T) {
+	schema := ObjectSchema{
+		Attributes: map[string]Schema{
+			"email":   &AttrSchema{Name: "email", Required: true, Type: String, Validators: []Validator{FormatValidator(FORMAT_EMAIL)}},
+			"api_key": &AttrSchema{Name: "api_key", Type: String, Sensitive: true},
+			"token":   &AttrSchema{Name: "token", Type: String},
+			"header":  MapBlockSchema("header", &ObjectSchema{Attributes: map[string]Schema{}}, "name"),
+		},
+		Constraints: []Constraint{ExactlyOneOf("api_key", "token")},
+	}
+	schema.Attributes["header"].(*BlockSchema).Required = true
+	out, err := schema.ToJSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Schema != JSON_SCHEMA_DIALECT {
+		t.Errorf("got $schema %q", out.Schema)
+	}
+	if !reflect.DeepEqual(out.Required, []string{"email", "header"}) {
+		t.Errorf("got required %q", out.Required)
+	}
+	if !out.Properties["api_key"].WriteOnly || out.Properties["token"].WriteOnly {
+		t.Errorf("got api_key %s and token %s, want only api_key writeOnly",
+			jsonSchemaString(t, out.Properties["api_key"]), jsonSchemaString(t, out.Properties["token"]))
+	}
+	if out.Properties["email"].Format != FORMAT_EMAIL {
+		t.Errorf("got email %s", jsonSchemaString(t, out.Properties["email"]))
+	}
+	if header := out.Properties["header"]; header.MinProperties == nil || *header.MinProperties != 1 {
+		t.Errorf("got required map block %s, want minProperties 1", jsonSchemaString(t, header))
+	}
+	want := `[{"oneOf":[{"required":["api_key"]},{"required":["token"]}]}]`
+	if got, _ := json.Marshal(out.AllOf); string(got) != want {
+		t.Errorf("got constraints %s, want %s", got, want)
+	}
+	if !strings.Contains(jsonSchemaString(t, out), `"additionalProperties":false`) {
+		t.Errorf("got %s, want additionalProperties false", jsonSchemaString(t, out))
+	}
+}
+
+func TestTypeJSONSchemaRoundTrip(t *testing.T) {
+	sensitiveString := String
+	sensitiveString.Sensitive = true
+	withDefaults, err := ObjectWithDefaults(map[string]Type{
+		"name":    String,
+		"retries": Number,
+		"tags":    List(String),
+	}, map[string]cty.Value{"retries": cty.NumberIntVal(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := map[string]Type{
+		"primitive":   Number,
+		"sensitive":   sensitiveString,
+		"collections": Map(Set(Bool)),
+		"tuple":       Tuple(String, Number),
+		"optional":    ObjectWithOptionalAttrs(map[string]Type{"id": String, "note": String}, "note"),
+		"defaults":    withDefaults,
+	}
+	for name, typ := range types {
+		t.Run(name, func(t *testing.T) {
+			out, err := typ.ToJSONSchema()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var in JSONSchema
+			if err := json.Unmarshal(data, &in); err != nil {
+				t.Fatal(err)
+			}
+			got, err := TypeFromJSONSchema(&in, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equals(typ) {
+				t.Errorf("got %s from %s, want %s", got, data, typ)
+			}
+		})
+	}
+}
+
+func TestTypeFromJSONSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		refs   map[string]*JSONSchema
+		want   Type
+	}{
+		{
+			name:   "allOf merges objects",
+			schema: `{"allOf":[{"type":"object","properties":{"id":{"type":"integer"}},"required":["id"]},{"properties":{"name":{"type":"string"}}}]}`,
+			want:   ObjectWithOptionalAttrs(map[string]Type{"id": Number, "name": String}, "name"),
+		},
+		{
+			name:   "allOf of other types",
+			schema: `{"allOf":[{"type":"string"},{"type":"object","properties":{"id":{"type":"string"}}}]}`,
+			want:   Dynamic,
+		},
+		{
+			name:   "oneOf of one type",
+			schema: `{"oneOf":[{"type":"string"},{"type":"null"},{"type":"string","format":"email"}]}`,
+			want:   String,
+		},
+		{
+			name:   "oneOf of different types",
+			schema: `{"oneOf":[{"type":"string"},{"type":"number"}]}`,
+			want:   Dynamic,
+		},
+		{
+			name:   "$defs",
+			schema: `{"type":"array","items":{"$ref":"#/$defs/tag"},"$defs":{"tag":{"type":"string"}}}`,
+			want:   List(String),
+		},
+		{
+			name:   "draft-07 definitions",
+			schema: `{"type":"object","additionalProperties":{"$ref":"#/definitions/count"},"definitions":{"count":{"type":"integer"}}}`,
+			want:   Map(Number),
+		},
+		{
+			name:   "external refs",
+			schema: `{"$ref":"#/components/schemas/Pet"}`,
+			refs: map[string]*JSONSchema{
+				"#/components/schemas/Pet": {Type: JSONSchemaTypes{JSON_SCHEMA_OBJECT}, Properties: map[string]*JSONSchema{
+					"name": {Type: JSONSchemaTypes{JSON_SCHEMA_STRING}},
+				}, Required: []string{"name"}},
+			},
+			want: Object(map[string]Type{"name": String}),
+		},
+		{
+			name:   "recursive refs",
+			schema: `{"$ref":"#/$defs/node","$defs":{"node":{"type":"object","properties":{"next":{"$ref":"#/$defs/node"}},"required":["next"]}}}`,
+			want:   Object(map[string]Type{"next": Dynamic}),
+		},
+		{
+			name:   "nullable and inferred types",
+			schema: `{"type":["string","null"],"enum":["a","b"]}`,
+			want:   String,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var schema JSONSchema
+			if err := json.Unmarshal([]byte(tt.schema), &schema); err != nil {
+				t.Fatal(err)
+			}
+			got, err := TypeFromJSONSchema(&schema, tt.refs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypeFromJSONSchemaDefaults(t *testing.T) {
+	var schema JSONSchema
+	err := json.Unmarshal([]byte(`{"type":"object","properties":{
+		"retries": {"type":"integer","default":3},
+		"region": {"type":"string","default":{"name":"eu"}},
+		"password": {"type":"string","format":"password"}
+	}}`), &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := TypeFromJSONSchema(&schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Defaults == nil || string((*got.Defaults)["retries"]) != "3" {
+		t.Errorf("got defaults %s, want retries to default to 3", typeJSON(t, got))
+	}
+	if _, ok := (*got.Defaults)["region"]; ok {
+		t.Errorf("got a default for region, which doesn't conform to its type")
+	}
+	if got.OptionalAttrs == nil || !reflect.DeepEqual(*got.OptionalAttrs, []string{"password", "region"}) {
+		t.Errorf("got optional attributes %s, want those without defaults", typeJSON(t, got))
+	}
+	if !(*got.NestedValues)["password"].Sensitive {
+		t.Errorf("got password %s, want it sensitive", typeJSON(t, (*got.NestedValues)["password"]))
+	}
+}
+
+func TestTypeFromJSONSchemaErrors(t *testing.T) {
+	for name, src := range map[string]string{
+		"unresolved ref": `{"type":"object","properties":{"pet":{"$ref":"#/components/schemas/Pet"}}}`,
+		"false":          `{"type":"array","items":false,"minItems":1}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var schema JSONSchema
+			if err := json.Unmarshal([]byte(src), &schema); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := TypeFromJSONSchema(&schema, nil); err == nil {
+				t.Errorf("got %s, want an error", got)
+			}
+		})
+	}
+}
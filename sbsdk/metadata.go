@@ -0,0 +1,35 @@
+package sbsdk
+
+// Metadata is human-readable documentation for a schema node, a type, an action or a trigger.
+// It is carried to the runner and CLI alongside schemas and types, so that help text and docs
+// are generated from the provider's code rather than maintained beside it.
+type Metadata struct {
+	//Description is a plain text description, suitable for terminal output
+	Description string `json:"description,omitempty"`
+	//MarkdownDescription is a richer description for generated documentation. Description is
+	//used in its place when it's empty.
+	MarkdownDescription string `json:"markdown_description,omitempty"`
+	//Examples are example values or configuration snippets, written in hcl
+	Examples []string `json:"examples,omitempty"`
+	//Deprecated marks something that should no longer be used. The CLI warns about configuration
+	//that uses deprecated attributes or blocks.
+	Deprecated bool `json:"deprecated,omitempty"`
+	//DeprecationMessage tells users what to use instead
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+}
+
+// Documented is implemented by Action and Trigger implementations that describe themselves.
+// Providers built with NewProvider return this Metadata from ActionMetadata and TriggerMetadata,
+// and an empty Metadata for actions and triggers that don't implement it.
+type Documented interface {
+	Metadata() Metadata
+}
+
+// metadataOf returns the Metadata of an Action or Trigger, if it has any.
+func metadataOf(v interface{}) Metadata {
+	documented, ok := v.(Documented)
+	if !ok {
+		return Metadata{}
+	}
+	return documented.Metadata()
+}
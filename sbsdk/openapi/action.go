@@ -0,0 +1,309 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/switchboard-org/plugin-sdk/sbsdk"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// Action performs one operation of an OpenAPI document. Its configuration has an attribute for
+// each of the operation's parameters, named after the parameter in snake_case, and a "body"
+// attribute for its JSON request body. Create Actions with Document.Actions.
+type Action struct {
+	//Method is the HTTP method of the operation, in upper case
+	Method string
+	//Path is the path of the operation, relative to the base URL, with {name} placeholders for
+	//its path parameters
+	Path string
+	//Operation is the operation the Action was generated from
+	Operation *Operation
+
+	schema          sbsdk.ObjectSchema
+	outputType      sbsdk.Type
+	params          []boundParameter
+	bodyAttr        string
+	bodyContentType string
+	opts            *options
+}
+
+func (a *Action) ConfigurationSchema() (sbsdk.ObjectSchema, error) {
+	return a.schema, nil
+}
+
+func (a *Action) OutputType() (sbsdk.Type, error) {
+	return a.outputType, nil
+}
+
+// Metadata documents the Action with the operation's summary and description.
+func (a *Action) Metadata() sbsdk.Metadata {
+	return sbsdk.Metadata{
+		Description:         a.Operation.Summary,
+		MarkdownDescription: a.Operation.Description,
+		Deprecated:          a.Operation.Deprecated,
+	}
+}
+
+// Evaluate builds the operation's request from input, sends it with the Client and decodes the
+// JSON body of the response into the output type. Failed requests are classified by their status
+// code: 429 is RateLimited, 401 and 403 are Unauthorized, 404 is NotFound, 409 is Conflict, 408
+// and 5xx are Retryable, and the rest are Permanent. Requests that fail to be sent are Retryable
+// when the network failed, and Permanent otherwise.
+func (a *Action) Evaluate(ctx context.Context, contextId string, input cty.Value) (cty.Value, error) {
+	input, _ = input.UnmarkDeep()
+	req, err := a.request(ctx, input)
+	if err != nil {
+		return cty.NilVal, sbsdk.Permanent(err)
+	}
+	for _, editor := range a.opts.editors {
+		if err := editor(ctx, contextId, req); err != nil {
+			return cty.NilVal, err
+		}
+	}
+	resp, err := a.opts.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return cty.NilVal, ctx.Err()
+		}
+		return cty.NilVal, doError(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return cty.NilVal, sbsdk.Retryable(err, 0)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return cty.NilVal, statusError(resp, data)
+	}
+	return a.decodeResponse(data)
+}
+
+// request builds the HTTP request for input.
+func (a *Action) request(ctx context.Context, input cty.Value) (*http.Request, error) {
+	path := a.Path
+	query := url.Values{}
+	header := http.Header{}
+	var cookies []*http.Cookie
+	for _, p := range a.params {
+		val := input.GetAttr(p.attr)
+		if val.IsNull() {
+			continue
+		}
+		values, err := parameterValues(val)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", p.param.Name, err)
+		}
+		switch p.param.In {
+		case PARAMETER_IN_PATH:
+			escaped := make([]string, 0, len(values))
+			for _, v := range values {
+				escaped = append(escaped, url.PathEscape(v))
+			}
+			path = strings.ReplaceAll(path, "{"+p.param.Name+"}", strings.Join(escaped, ","))
+		case PARAMETER_IN_QUERY:
+			for _, v := range values {
+				query.Add(p.param.Name, v)
+			}
+		case PARAMETER_IN_HEADER:
+			header.Set(p.param.Name, strings.Join(values, ","))
+		case PARAMETER_IN_COOKIE:
+			cookies = append(cookies, &http.Cookie{Name: p.param.Name, Value: strings.Join(values, ",")})
+		}
+	}
+
+	target := strings.TrimSuffix(a.opts.baseURL, "/") + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	var body io.Reader
+	if a.bodyAttr != "" {
+		if val := input.GetAttr(a.bodyAttr); !val.IsNull() {
+			data, err := json.Marshal(jsonValue(val))
+			if err != nil {
+				return nil, fmt.Errorf("request body: %w", err)
+			}
+			body = bytes.NewReader(data)
+			header.Set("Content-Type", a.bodyContentType)
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, a.Method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	return req, nil
+}
+
+// decodeResponse decodes a JSON response body into the output type. Attributes the output type
+// doesn't declare are dropped, and an empty body is a null value. The body is converted to the
+// type constraint returned by ToCty, so that optional attributes it leaves out become null and
+// required ones are reported, while the decoded value's type has no optional attributes.
+func (a *Action) decodeResponse(data []byte) (cty.Value, error) {
+	ty := a.outputType.ToCty()
+	if len(bytes.TrimSpace(data)) == 0 {
+		return cty.NullVal(ty.WithoutOptionalAttributesDeep()), nil
+	}
+	impliedType, err := ctyjson.ImpliedType(data)
+	if err != nil {
+		return cty.NilVal, sbsdk.Permanent(fmt.Errorf("response is not JSON: %w", err))
+	}
+	val, err := ctyjson.Unmarshal(data, impliedType)
+	if err != nil {
+		return cty.NilVal, sbsdk.Permanent(fmt.Errorf("response is not JSON: %w", err))
+	}
+	out, err := convert.Convert(val, ty)
+	if err != nil {
+		return cty.NilVal, sbsdk.Permanent(fmt.Errorf("response does not match the output type: %w", err))
+	}
+	return out, nil
+}
+
+// parameterValues converts the value of a parameter into strings. Lists, sets and tuples of
+// primitives have one string per element, and other values that aren't primitives are JSON.
+func parameterValues(val cty.Value) ([]string, error) {
+	ty := val.Type()
+	if ty.IsListType() || ty.IsSetType() || ty.IsTupleType() {
+		var out []string
+		for iter := val.ElementIterator(); iter.Next(); {
+			_, el := iter.Element()
+			if el.IsNull() {
+				continue
+			}
+			if !el.Type().IsPrimitiveType() {
+				return nil, errors.New("elements must be strings, numbers or bools")
+			}
+			out = append(out, primitiveString(el))
+		}
+		return out, nil
+	}
+	if ty.IsPrimitiveType() {
+		return []string{primitiveString(val)}, nil
+	}
+	data, err := json.Marshal(jsonValue(val))
+	if err != nil {
+		return nil, err
+	}
+	return []string{string(data)}, nil
+}
+
+func primitiveString(val cty.Value) string {
+	switch val.Type() {
+	case cty.Number:
+		return val.AsBigFloat().Text('f', -1)
+	case cty.Bool:
+		return strconv.FormatBool(val.True())
+	default:
+		return val.AsString()
+	}
+}
+
+// jsonValue converts val into a value that encoding/json encodes as plain JSON. Null object
+// attributes are left out, so that optional attributes that aren't set aren't sent.
+func jsonValue(val cty.Value) interface{} {
+	if val.IsNull() {
+		return nil
+	}
+	ty := val.Type()
+	switch {
+	case ty == cty.String:
+		return val.AsString()
+	case ty == cty.Number:
+		return json.Number(val.AsBigFloat().Text('f', -1))
+	case ty == cty.Bool:
+		return val.True()
+	case ty.IsObjectType() || ty.IsMapType():
+		out := map[string]interface{}{}
+		for k, v := range val.AsValueMap() {
+			if ty.IsObjectType() && v.IsNull() {
+				continue
+			}
+			out[k] = jsonValue(v)
+		}
+		return out
+	default:
+		out := []interface{}{}
+		for iter := val.ElementIterator(); iter.Next(); {
+			_, el := iter.Element()
+			out = append(out, jsonValue(el))
+		}
+		return out
+	}
+}
+
+// doError classifies an error returned by Client.Do. Network failures and timeouts are Retryable,
+// while anything else, such as an unsupported URL scheme or an error from a Client that wraps
+// http.Client, is Permanent. Errors that the Client already classified are returned as they are.
+func doError(err error) error {
+	if sbsdk.ErrorKindOf(err) != sbsdk.ErrorKindUnknown {
+		return err
+	}
+	inner := err
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		//url.Error implements net.Error itself, so the error it wraps is what tells them apart
+		inner = urlErr.Err
+	}
+	var netErr net.Error
+	if errors.As(inner, &netErr) || errors.Is(inner, io.EOF) || errors.Is(inner, io.ErrUnexpectedEOF) {
+		return sbsdk.Retryable(err, 0)
+	}
+	return sbsdk.Permanent(err)
+}
+
+// statusError classifies a response with an unsuccessful status code.
+func statusError(resp *http.Response, body []byte) error {
+	err := fmt.Errorf("%s %s: %s", resp.Request.Method, resp.Request.URL.Path, resp.Status)
+	if detail := strings.TrimSpace(string(body)); detail != "" {
+		err = fmt.Errorf("%w: %s", err, detail)
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return sbsdk.RateLimited(err, retryAfter(resp.Header.Get("Retry-After")))
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return sbsdk.Unauthorized(err)
+	case resp.StatusCode == http.StatusNotFound:
+		return sbsdk.NotFound(err)
+	case resp.StatusCode == http.StatusConflict:
+		return sbsdk.Conflict(err)
+	case resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode >= 500:
+		return sbsdk.Retryable(err, retryAfter(resp.Header.Get("Retry-After")))
+	default:
+		return sbsdk.Permanent(err)
+	}
+}
+
+// retryAfter parses a Retry-After header, which is a number of seconds or an HTTP date. It
+// returns zero when the header is missing or invalid.
+func retryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,239 @@
+// Package openapi generates sbsdk Actions from an OpenAPI 3 document, so that providers wrapping
+// a REST API don't have to hand-write an Action for every operation. Each operation becomes an
+// Action whose configuration holds the operation's parameters and request body, whose output is
+// the JSON body of its successful response, and whose Evaluate performs the HTTP call.
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/switchboard-org/plugin-sdk/sbsdk"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	PARAMETER_IN_PATH   = "path"
+	PARAMETER_IN_QUERY  = "query"
+	PARAMETER_IN_HEADER = "header"
+	PARAMETER_IN_COOKIE = "cookie"
+)
+
+// Document is the part of an OpenAPI 3 document that actions are generated from.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Server is a base URL of the API. Variables in the URL, such as {region}, are replaced by
+// their defaults.
+type Server struct {
+	URL       string                    `json:"url"`
+	Variables map[string]ServerVariable `json:"variables,omitempty"`
+}
+
+type ServerVariable struct {
+	Default string `json:"default"`
+}
+
+// PathItem holds the operations on one path. Its Parameters apply to every one of them.
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters,omitempty"`
+	Get        *Operation   `json:"get,omitempty"`
+	Put        *Operation   `json:"put,omitempty"`
+	Post       *Operation   `json:"post,omitempty"`
+	Delete     *Operation   `json:"delete,omitempty"`
+	Options    *Operation   `json:"options,omitempty"`
+	Head       *Operation   `json:"head,omitempty"`
+	Patch      *Operation   `json:"patch,omitempty"`
+	Trace      *Operation   `json:"trace,omitempty"`
+}
+
+// operations returns the operations of the path item keyed by their HTTP method.
+func (p *PathItem) operations() map[string]*Operation {
+	out := map[string]*Operation{}
+	for method, op := range map[string]*Operation{
+		"GET": p.Get, "PUT": p.Put, "POST": p.Post, "DELETE": p.Delete,
+		"OPTIONS": p.Options, "HEAD": p.Head, "PATCH": p.Patch, "TRACE": p.Trace,
+	} {
+		if op != nil {
+			out[method] = op
+		}
+	}
+	return out
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Deprecated  bool                 `json:"deprecated,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses,omitempty"`
+}
+
+type Parameter struct {
+	Ref         string            `json:"$ref,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	In          string            `json:"in,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Required    bool              `json:"required,omitempty"`
+	Deprecated  bool              `json:"deprecated,omitempty"`
+	Schema      *sbsdk.JSONSchema `json:"schema,omitempty"`
+}
+
+type RequestBody struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *sbsdk.JSONSchema `json:"schema,omitempty"`
+}
+
+// Components holds the reusable objects that the rest of the document refers to with $ref.
+type Components struct {
+	Schemas       map[string]*sbsdk.JSONSchema `json:"schemas,omitempty"`
+	Parameters    map[string]*Parameter        `json:"parameters,omitempty"`
+	RequestBodies map[string]*RequestBody      `json:"requestBodies,omitempty"`
+	Responses     map[string]*Response         `json:"responses,omitempty"`
+}
+
+// Load reads an OpenAPI 3 document, in JSON or YAML, from a local file.
+func Load(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return doc, nil
+}
+
+// Parse parses an OpenAPI 3 document in JSON or YAML.
+func Parse(data []byte) (*Document, error) {
+	if !json.Valid(data) {
+		var raw interface{}
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+		converted, err := json.Marshal(yamlToJSON(raw))
+		if err != nil {
+			return nil, err
+		}
+		data = converted
+	}
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, errors.New("only OpenAPI 3 documents are supported")
+	}
+	return &doc, nil
+}
+
+// yamlToJSON converts the maps decoded by yaml, which may have non-string keys such as
+// response codes, into maps that can be encoded as JSON.
+func yamlToJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, el := range v {
+			out[k] = yamlToJSON(el)
+		}
+		return out
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, el := range v {
+			out[fmt.Sprint(k)] = yamlToJSON(el)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, el := range v {
+			out = append(out, yamlToJSON(el))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// schemaRefs maps the references to the document's component schemas to the schemas.
+func (d *Document) schemaRefs() map[string]*sbsdk.JSONSchema {
+	out := make(map[string]*sbsdk.JSONSchema, len(d.Components.Schemas))
+	for name, schema := range d.Components.Schemas {
+		out["#/components/schemas/"+name] = schema
+	}
+	return out
+}
+
+func (d *Document) parameter(p *Parameter) (*Parameter, error) {
+	return resolve(p, func(p *Parameter) string { return p.Ref }, d.Components.Parameters, "#/components/parameters/")
+}
+
+func (d *Document) requestBody(b *RequestBody) (*RequestBody, error) {
+	return resolve(b, func(b *RequestBody) string { return b.Ref }, d.Components.RequestBodies, "#/components/requestBodies/")
+}
+
+func (d *Document) response(r *Response) (*Response, error) {
+	return resolve(r, func(r *Response) string { return r.Ref }, d.Components.Responses, "#/components/responses/")
+}
+
+// resolve follows the $refs of v, as returned by ref, to the components whose references start
+// with prefix, until it reaches one without a $ref. An error is returned for $refs that can't be
+// resolved, or that form a cycle.
+func resolve[T any](v T, ref func(T) string, components map[string]T, prefix string) (T, error) {
+	visited := map[string]bool{}
+	for ref(v) != "" {
+		r := ref(v)
+		if visited[r] {
+			var zero T
+			return zero, fmt.Errorf("$ref %q refers to itself", r)
+		}
+		visited[r] = true
+		resolved, ok := components[strings.TrimPrefix(r, prefix)]
+		if !ok || !strings.HasPrefix(r, prefix) {
+			var zero T
+			return zero, fmt.Errorf("cannot resolve $ref %q", r)
+		}
+		v = resolved
+	}
+	return v, nil
+}
+
+// baseURL is the URL of the first server, with its variables replaced by their defaults.
+func (d *Document) baseURL() string {
+	if len(d.Servers) == 0 {
+		return ""
+	}
+	out := d.Servers[0].URL
+	for name, variable := range d.Servers[0].Variables {
+		out = strings.ReplaceAll(out, "{"+name+"}", variable.Default)
+	}
+	return out
+}
@@ -0,0 +1,427 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/switchboard-org/plugin-sdk/sbsdk"
+)
+
+// Client sends the HTTP requests of generated actions. *http.Client implements it, and providers
+// can wrap one to add authentication, retries or logging.
+type Client interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RequestEditor changes a request before it is sent, typically to add the credentials that the
+// runner holds in the user config for contextId.
+type RequestEditor func(ctx context.Context, contextId string, req *http.Request) error
+
+// Option configures the actions generated by Document.Actions.
+type Option func(*options)
+
+type options struct {
+	client     Client
+	baseURL    string
+	editors    []RequestEditor
+	operations []string
+}
+
+// WithClient sets the Client that sends requests. http.DefaultClient is used by default.
+func WithClient(client Client) Option {
+	return func(o *options) {
+		o.client = client
+	}
+}
+
+// WithBaseURL sets the URL that operation paths are relative to, in place of the first of the
+// document's servers.
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithRequestEditor adds a RequestEditor, which is called on every request after the earlier ones.
+func WithRequestEditor(editor RequestEditor) Option {
+	return func(o *options) {
+		o.editors = append(o.editors, editor)
+	}
+}
+
+// WithOperations limits generation to the actions with the given names, so that operations
+// that are not needed, or not supported, are left out.
+func WithOperations(names ...string) Option {
+	return func(o *options) {
+		o.operations = append(o.operations, names...)
+	}
+}
+
+// Actions generates an Action for each operation in the document, keyed by its name. The name is
+// the operation's operationId in snake_case, or its method and path when it has none.
+//
+// Operations that can't be generated, such as those whose request body isn't JSON, are left out,
+// and reported by a warning each. The returned Diagnostics have errors, and no actions are
+// returned, when the base URL isn't absolute, when two operations have the same name, or when an
+// operation passed to WithOperations is missing or can't be generated.
+func (d *Document) Actions(opts ...Option) (map[string]*Action, sbsdk.Diagnostics) {
+	o := &options{client: http.DefaultClient, baseURL: d.baseURL()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if base, err := url.Parse(o.baseURL); err != nil || !base.IsAbs() {
+		return nil, sbsdk.Diagnostics{{
+			Severity: sbsdk.DiagError,
+			Summary:  "Invalid base URL",
+			Detail:   fmt.Sprintf("The base URL %q is not absolute. Add a server to the document, or use WithBaseURL.", o.baseURL),
+		}}
+	}
+	paths := make([]string, 0, len(d.Paths))
+	for path := range d.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	out := make(map[string]*Action)
+	var diags sbsdk.Diagnostics
+	for _, path := range paths {
+		item := d.Paths[path]
+		ops := item.operations()
+		methods := make([]string, 0, len(ops))
+		for method := range ops {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			op := ops[method]
+			name := actionName(method, path, op)
+			requested := contains(o.operations, name)
+			if len(o.operations) > 0 && !requested {
+				continue
+			}
+			if _, exists := out[name]; exists {
+				diags = append(diags, sbsdk.Diagnostic{
+					Severity: sbsdk.DiagError,
+					Summary:  "Duplicate operation name",
+					Detail:   fmt.Sprintf("More than one operation is named %q.", name),
+				})
+				continue
+			}
+			action, err := d.newAction(method, path, item, op, o)
+			if err != nil {
+				diag := sbsdk.Diagnostic{
+					Severity: sbsdk.DiagWarning,
+					Summary:  "Unsupported operation",
+					Detail:   fmt.Sprintf("%s %s is left out: %s.", method, path, err),
+				}
+				if requested {
+					diag.Severity = sbsdk.DiagError
+					diag.Detail = fmt.Sprintf("%s %s cannot be generated: %s.", method, path, err)
+				}
+				diags = append(diags, diag)
+				continue
+			}
+			out[name] = action
+		}
+	}
+	for _, name := range o.operations {
+		if !contains(d.operationNames(), name) {
+			diags = append(diags, sbsdk.Diagnostic{
+				Severity: sbsdk.DiagError,
+				Summary:  "Unknown operation",
+				Detail:   fmt.Sprintf("No operation is named %q.", name),
+			})
+		}
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return out, diags
+}
+
+// operationNames returns the action names of all the operations in the document.
+func (d *Document) operationNames() []string {
+	var out []string
+	for path, item := range d.Paths {
+		for method, op := range item.operations() {
+			out = append(out, actionName(method, path, op))
+		}
+	}
+	return out
+}
+
+// ProviderOptions generates the document's actions like Actions, and returns an sbsdk.WithAction
+// for each of them, to be passed to sbsdk.NewProvider, along with the Diagnostics of Actions.
+func (d *Document) ProviderOptions(opts ...Option) ([]sbsdk.ProviderOption, sbsdk.Diagnostics) {
+	actions, diags := d.Actions(opts...)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	names := make([]string, 0, len(actions))
+	for name := range actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]sbsdk.ProviderOption, 0, len(names))
+	for _, name := range names {
+		out = append(out, sbsdk.WithAction(name, actions[name]))
+	}
+	return out, diags
+}
+
+// boundParameter is an operation parameter and the attribute of the configuration that holds it.
+type boundParameter struct {
+	attr  string
+	param *Parameter
+}
+
+func (d *Document) newAction(method string, path string, item *PathItem, op *Operation, o *options) (*Action, error) {
+	refs := d.schemaRefs()
+	action := &Action{
+		Method:    method,
+		Path:      path,
+		Operation: op,
+		schema:    sbsdk.ObjectSchema{Attributes: map[string]sbsdk.Schema{}},
+		opts:      o,
+	}
+
+	params, err := d.parameters(item, op)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range params {
+		attr := identifier(p.Name)
+		if _, taken := action.schema.Attributes[attr]; taken {
+			attr = identifier(p.In + "_" + p.Name)
+		}
+		attrType := sbsdk.String
+		if p.Schema != nil {
+			attrType, err = sbsdk.TypeFromJSONSchema(p.Schema, refs)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %q: %w", p.Name, err)
+			}
+		}
+		attrSchema := &sbsdk.AttrSchema{
+			Name:       attr,
+			Required:   p.Required || p.In == PARAMETER_IN_PATH,
+			Type:       attrType,
+			Validators: validators(p.Schema, refs),
+		}
+		attrSchema.Description = p.Description
+		attrSchema.Deprecated = p.Deprecated
+		action.schema.Attributes[attr] = attrSchema
+		action.params = append(action.params, boundParameter{attr: attr, param: p})
+	}
+
+	if op.RequestBody != nil {
+		body, err := d.requestBody(op.RequestBody)
+		if err != nil {
+			return nil, err
+		}
+		contentType, media := jsonContent(body.Content)
+		if media == nil && len(body.Content) > 0 {
+			return nil, fmt.Errorf("request bodies of type %s are not supported", strings.Join(contentTypes(body.Content), ", "))
+		}
+		bodyType := sbsdk.Dynamic
+		if media != nil && media.Schema != nil {
+			bodyType, err = sbsdk.TypeFromJSONSchema(media.Schema, refs)
+			if err != nil {
+				return nil, fmt.Errorf("request body: %w", err)
+			}
+		}
+		action.bodyAttr = "body"
+		if _, taken := action.schema.Attributes[action.bodyAttr]; taken {
+			action.bodyAttr = "request_body"
+		}
+		action.bodyContentType = contentType
+		attrSchema := &sbsdk.AttrSchema{
+			Name:     action.bodyAttr,
+			Required: body.Required,
+			Type:     bodyType,
+		}
+		attrSchema.Description = body.Description
+		action.schema.Attributes[action.bodyAttr] = attrSchema
+	}
+
+	action.outputType, err = d.outputType(op, refs)
+	if err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// parameters returns the parameters of op, including those of its path item that it doesn't override.
+func (d *Document) parameters(item *PathItem, op *Operation) ([]*Parameter, error) {
+	var out []*Parameter
+	index := map[string]int{}
+	for _, p := range append(append([]*Parameter{}, item.Parameters...), op.Parameters...) {
+		resolved, err := d.parameter(p)
+		if err != nil {
+			return nil, err
+		}
+		key := resolved.In + ":" + resolved.Name
+		if i, ok := index[key]; ok {
+			out[i] = resolved
+			continue
+		}
+		index[key] = len(out)
+		out = append(out, resolved)
+	}
+	return out, nil
+}
+
+// outputType is the type of the JSON body of the first successful response of op, or Dynamic
+// when the response has no JSON body.
+func (d *Document) outputType(op *Operation, refs map[string]*sbsdk.JSONSchema) (sbsdk.Type, error) {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	if len(codes) == 0 {
+		if _, ok := op.Responses["default"]; ok {
+			codes = append(codes, "default")
+		}
+	}
+	for _, code := range codes {
+		response, err := d.response(op.Responses[code])
+		if err != nil {
+			return sbsdk.Invalid, err
+		}
+		if _, media := jsonContent(response.Content); media != nil && media.Schema != nil {
+			out, err := sbsdk.TypeFromJSONSchema(media.Schema, refs)
+			if err != nil {
+				return sbsdk.Invalid, fmt.Errorf("response %s: %w", code, err)
+			}
+			return out, nil
+		}
+	}
+	return sbsdk.Dynamic, nil
+}
+
+// jsonContent returns the JSON media type of content, if it has one.
+func jsonContent(content map[string]*MediaType) (string, *MediaType) {
+	if media, ok := content["application/json"]; ok {
+		return "application/json", media
+	}
+	for _, contentType := range contentTypes(content) {
+		if strings.HasSuffix(strings.SplitN(contentType, ";", 2)[0], "json") {
+			return contentType, content[contentType]
+		}
+	}
+	return "", nil
+}
+
+func contentTypes(content map[string]*MediaType) []string {
+	out := make([]string, 0, len(content))
+	for contentType := range content {
+		out = append(out, contentType)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// validators derives the validators of a parameter from the validation keywords of its schema.
+// A schema whose $refs form a cycle has none.
+func validators(schema *sbsdk.JSONSchema, refs map[string]*sbsdk.JSONSchema) []sbsdk.Validator {
+	seen := map[string]bool{}
+	for schema != nil && schema.Ref != "" && !seen[schema.Ref] {
+		seen[schema.Ref] = true
+		schema = refs[schema.Ref]
+	}
+	if schema == nil || schema.Ref != "" {
+		return nil
+	}
+	var out []sbsdk.Validator
+	if len(schema.Enum) > 0 {
+		values := make([]string, 0, len(schema.Enum))
+		for _, raw := range schema.Enum {
+			var str string
+			if err := json.Unmarshal(raw, &str); err == nil {
+				values = append(values, str)
+			} else if string(raw) != "null" {
+				values = append(values, string(raw))
+			}
+		}
+		out = append(out, sbsdk.EnumValidator(values...))
+	}
+	if schema.Pattern != "" {
+		out = append(out, sbsdk.RegexValidator(schema.Pattern))
+	}
+	if schema.Minimum != nil || schema.Maximum != nil {
+		out = append(out, sbsdk.Validator{Kind: sbsdk.VALIDATOR_RANGE, Min: schema.Minimum, Max: schema.Maximum})
+	}
+	toFloat := func(i *int) *float64 {
+		if i == nil {
+			return nil
+		}
+		f := float64(*i)
+		return &f
+	}
+	if schema.MinLength != nil || schema.MaxLength != nil {
+		out = append(out, sbsdk.Validator{Kind: sbsdk.VALIDATOR_LENGTH, Min: toFloat(schema.MinLength), Max: toFloat(schema.MaxLength)})
+	}
+	if schema.MinItems != nil || schema.MaxItems != nil {
+		out = append(out, sbsdk.Validator{Kind: sbsdk.VALIDATOR_LENGTH, Min: toFloat(schema.MinItems), Max: toFloat(schema.MaxItems)})
+	}
+	switch schema.Format {
+	case "uri", "url":
+		out = append(out, sbsdk.FormatValidator(sbsdk.FORMAT_URL))
+	case sbsdk.FORMAT_EMAIL, sbsdk.FORMAT_UUID, sbsdk.FORMAT_DATE, sbsdk.FORMAT_DATE_TIME, sbsdk.FORMAT_IPV4, sbsdk.FORMAT_IPV6:
+		out = append(out, sbsdk.FormatValidator(schema.Format))
+	}
+	return out
+}
+
+// actionName is the snake_case operationId of op, or its method and path when it has none.
+func actionName(method string, path string, op *Operation) string {
+	if op.OperationID != "" {
+		return identifier(op.OperationID)
+	}
+	return identifier(strings.ToLower(method) + "_" + path)
+}
+
+// identifier converts s, such as an operationId or parameter name, into a snake_case hcl identifier.
+func identifier(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	out := b.String()
+	for strings.Contains(out, "__") {
+		out = strings.ReplaceAll(out, "__", "_")
+	}
+	out = strings.Trim(out, "_")
+	if out == "" || unicode.IsDigit([]rune(out)[0]) {
+		out = "_" + out
+	}
+	return out
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,367 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/switchboard-org/plugin-sdk/sbsdk"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const petstore = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: "1"
+servers:
+  - url: https://{region}.example.com/v1
+    variables:
+      region:
+        default: eu
+paths:
+  /pets/{petId}:
+    parameters:
+      - $ref: "#/components/parameters/petId"
+    get:
+      operationId: getPet
+      parameters:
+        - name: fields
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+        - name: X-Request-Id
+          in: header
+          schema:
+            type: string
+        - name: session
+          in: cookie
+          schema:
+            type: string
+      responses:
+        "200":
+          description: The pet
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Pet"
+    put:
+      operationId: updatePet
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Pet"
+      responses:
+        "204":
+          description: Updated
+  /pets/{petId}/photo:
+    parameters:
+      - $ref: "#/components/parameters/petId"
+    post:
+      operationId: uploadPhoto
+      requestBody:
+        content:
+          image/png:
+            schema:
+              type: string
+      responses:
+        "204":
+          description: Uploaded
+components:
+  parameters:
+    petId:
+      name: petId
+      in: path
+      required: true
+      schema:
+        type: string
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        tag:
+          type: string
+        parent:
+          $ref: "#/components/schemas/Pet"
+`
+
+func parsePetstore(t *testing.T) *Document {
+	t.Helper()
+	doc, err := Parse([]byte(petstore))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func generate(t *testing.T, doc *Document, name string, opts ...Option) *Action {
+	t.Helper()
+	actions, diags := doc.Actions(append(opts, WithOperations(name))...)
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+	return actions[name]
+}
+
+func evaluate(t *testing.T, action *Action, input map[string]cty.Value) (cty.Value, error) {
+	t.Helper()
+	schema, err := action.ConfigurationSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := sbsdk.MarshalVal(&schema, cty.ObjectVal(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	val, err := sbsdk.MapInputToCtyValue(data, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return action.Evaluate(context.Background(), "ctx", val)
+}
+
+// getPetInput is the input of get_pet for id, with its optional parameters left out.
+func getPetInput(id string) map[string]cty.Value {
+	return map[string]cty.Value{
+		"pet_id":       cty.StringVal(id),
+		"fields":       cty.NullVal(cty.List(cty.String)),
+		"x_request_id": cty.NullVal(cty.String),
+		"session":      cty.NullVal(cty.String),
+	}
+}
+
+func TestActionsSkipUnsupportedOperations(t *testing.T) {
+	actions, diags := parsePetstore(t).Actions()
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+	if _, ok := actions["get_pet"]; !ok {
+		t.Errorf("got actions %v, want get_pet", actions)
+	}
+	if _, ok := actions["upload_photo"]; ok {
+		t.Error("got an action for an operation with a non-JSON body")
+	}
+	if len(diags) != 1 || diags[0].Severity != sbsdk.DiagWarning || !strings.Contains(diags[0].Detail, "image/png") {
+		t.Errorf("got diagnostics %#v, want a warning about upload_photo", diags)
+	}
+}
+
+func TestActionsErrors(t *testing.T) {
+	tests := map[string]struct {
+		doc  func(*Document)
+		opts []Option
+		want string
+	}{
+		"relative base URL": {
+			opts: []Option{WithBaseURL("/v1")},
+			want: "Invalid base URL",
+		},
+		"no servers": {
+			doc:  func(d *Document) { d.Servers = nil },
+			want: "Invalid base URL",
+		},
+		"requested unsupported operation": {
+			opts: []Option{WithOperations("upload_photo")},
+			want: "Unsupported operation",
+		},
+		"unknown operation": {
+			opts: []Option{WithOperations("delete_pet")},
+			want: "Unknown operation",
+		},
+		"cyclic parameter": {
+			doc: func(d *Document) {
+				d.Components.Parameters["petId"] = &Parameter{Ref: "#/components/parameters/petId"}
+			},
+			opts: []Option{WithOperations("get_pet")},
+			want: "refers to itself",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			doc := parsePetstore(t)
+			if tt.doc != nil {
+				tt.doc(doc)
+			}
+			actions, diags := doc.Actions(tt.opts...)
+			if !diags.HasErrors() || !strings.Contains(diags.Error(), tt.want) {
+				t.Fatalf("got diagnostics %v, want an error containing %q", diags, tt.want)
+			}
+			if actions != nil {
+				t.Errorf("got actions %v along with errors", actions)
+			}
+		})
+	}
+}
+
+func TestCyclicSchemaRefs(t *testing.T) {
+	doc := parsePetstore(t)
+	doc.Components.Schemas["A"] = &sbsdk.JSONSchema{Ref: "#/components/schemas/B"}
+	doc.Components.Schemas["B"] = &sbsdk.JSONSchema{Ref: "#/components/schemas/A"}
+	doc.Components.Parameters["petId"].Schema = &sbsdk.JSONSchema{Ref: "#/components/schemas/A"}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		generate(t, doc, "get_pet")
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("generating an action with cyclic $refs never finished")
+	}
+}
+
+func TestRequest(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"name":"rex","unknown":true,"parent":{"name":"max"}}`)
+	}))
+	defer server.Close()
+	doc := parsePetstore(t)
+	action := generate(t, doc, "get_pet", WithBaseURL(server.URL+"/v1/"), WithClient(server.Client()),
+		WithRequestEditor(func(_ context.Context, contextId string, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+contextId)
+			return nil
+		}))
+	out, err := evaluate(t, action, map[string]cty.Value{
+		"pet_id":       cty.StringVal("a b/c"),
+		"fields":       cty.ListVal([]cty.Value{cty.StringVal("name"), cty.StringVal("tag")}),
+		"x_request_id": cty.StringVal("42"),
+		"session":      cty.StringVal("s1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != http.MethodGet || got.URL.EscapedPath() != "/v1/pets/a%20b%2Fc" {
+		t.Errorf("got %s %s", got.Method, got.URL.EscapedPath())
+	}
+	if q := got.URL.Query()["fields"]; len(q) != 2 || q[0] != "name" || q[1] != "tag" {
+		t.Errorf("got query %q", got.URL.RawQuery)
+	}
+	if got.Header.Get("X-Request-Id") != "42" || got.Header.Get("Authorization") != "Bearer ctx" || got.Header.Get("Accept") != "application/json" {
+		t.Errorf("got headers %v", got.Header)
+	}
+	if cookie, err := got.Cookie("session"); err != nil || cookie.Value != "s1" {
+		t.Errorf("got cookie %v", cookie)
+	}
+	if len(body) != 0 {
+		t.Errorf("got body %q for a GET", body)
+	}
+	if out.GetAttr("name").AsString() != "rex" || !out.GetAttr("tag").IsNull() {
+		t.Errorf("got output %#v", out)
+	}
+
+	action = generate(t, doc, "update_pet", WithBaseURL(server.URL), WithClient(server.Client()))
+	_, err = evaluate(t, action, map[string]cty.Value{
+		"pet_id": cty.StringVal("1"),
+		"body":   cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("rex")}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sent map[string]interface{}
+	if err := json.Unmarshal(body, &sent); err != nil || len(sent) != 1 || sent["name"] != "rex" {
+		t.Errorf("got body %s, want only the attributes that are set", body)
+	}
+	if got.Method != http.MethodPut || got.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("got %s with content type %q", got.Method, got.Header.Get("Content-Type"))
+	}
+}
+
+func TestStatusClassification(t *testing.T) {
+	tests := []struct {
+		status     int
+		retryAfter string
+		want       sbsdk.ErrorKind
+		wantDelay  time.Duration
+	}{
+		{http.StatusTooManyRequests, "7", sbsdk.ErrorKindRateLimited, 7 * time.Second},
+		{http.StatusUnauthorized, "", sbsdk.ErrorKindUnauthorized, 0},
+		{http.StatusForbidden, "", sbsdk.ErrorKindUnauthorized, 0},
+		{http.StatusNotFound, "", sbsdk.ErrorKindNotFound, 0},
+		{http.StatusConflict, "", sbsdk.ErrorKindConflict, 0},
+		{http.StatusRequestTimeout, "", sbsdk.ErrorKindRetryable, 0},
+		{http.StatusServiceUnavailable, "2", sbsdk.ErrorKindRetryable, 2 * time.Second},
+		{http.StatusBadRequest, "", sbsdk.ErrorKindPermanent, 0},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+				io.WriteString(w, "vendor says no")
+			}))
+			defer server.Close()
+			action := generate(t, parsePetstore(t), "get_pet", WithBaseURL(server.URL), WithClient(server.Client()))
+			_, err := evaluate(t, action, getPetInput("1"))
+			if sbsdk.ErrorKindOf(err) != tt.want {
+				t.Fatalf("got %v of kind %s, want %s", err, sbsdk.ErrorKindOf(err), tt.want)
+			}
+			if delay, _ := sbsdk.RetryAfterOf(err); delay != tt.wantDelay {
+				t.Errorf("got retry after %s, want %s", delay, tt.wantDelay)
+			}
+			if !strings.Contains(err.Error(), "vendor says no") {
+				t.Errorf("got %q, want the response body in the error", err)
+			}
+		})
+	}
+}
+
+type clientFunc func(req *http.Request) (*http.Response, error)
+
+func (f clientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientErrorClassification(t *testing.T) {
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	tests := map[string]struct {
+		client Client
+		want   sbsdk.ErrorKind
+	}{
+		"network failure": {
+			client: closed.Client(),
+			want:   sbsdk.ErrorKindRetryable,
+		},
+		"client error": {
+			client: clientFunc(func(*http.Request) (*http.Response, error) {
+				return nil, errors.New("no credentials for this context")
+			}),
+			want: sbsdk.ErrorKindPermanent,
+		},
+		"classified by the client": {
+			client: clientFunc(func(*http.Request) (*http.Response, error) {
+				return nil, sbsdk.RateLimited(errors.New("local rate limit"), time.Second)
+			}),
+			want: sbsdk.ErrorKindRateLimited,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			action := generate(t, parsePetstore(t), "get_pet", WithBaseURL(closed.URL), WithClient(tt.client))
+			_, err := evaluate(t, action, getPetInput("1"))
+			if sbsdk.ErrorKindOf(err) != tt.want {
+				t.Errorf("got %v of kind %s, want %s", err, sbsdk.ErrorKindOf(err), tt.want)
+			}
+		})
+	}
+}
@@ -1,24 +1,90 @@
 package sbsdk
 
 import (
+	"context"
 	"github.com/hashicorp/go-plugin"
+	"github.com/switchboard-org/plugin-sdk/sbsdk/sbproto"
+	"google.golang.org/grpc"
 	"net/rpc"
+	"sync"
 )
 
+// ProviderPlugin is the go-plugin glue for providers. Impl is only set on the provider side;
+// wrap a Provider with AdaptProvider to serve it.
 type ProviderPlugin struct {
-	Impl Provider
+	Impl ProviderV3
 }
 
-func (p *ProviderPlugin) Server(*plugin.MuxBroker) (interface{}, error) {
-	return &ProviderRPCServer{Impl: p.Impl}, nil
+func (p *ProviderPlugin) Server(b *plugin.MuxBroker) (interface{}, error) {
+	return &ProviderRPCServer{Impl: p.Impl, broker: b}, nil
 }
 
-func (p *ProviderPlugin) Client(_ *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
-	return &ProviderRPCClient{client: c}, nil
+func (p *ProviderPlugin) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
+	return &ProviderRPCClient{client: c, broker: b}, nil
 }
 
+func (p *ProviderPlugin) GRPCServer(b *plugin.GRPCBroker, s *grpc.Server) error {
+	sbproto.RegisterProviderServer(s, &ProviderGRPCServer{Impl: p.Impl, broker: b})
+	return nil
+}
+
+func (p *ProviderPlugin) GRPCClient(_ context.Context, b *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &ProviderGRPCClient{client: sbproto.NewProviderClient(c), broker: b}, nil
+}
+
+// HandshakeConfig is shared by providers and the runner. Its ProtocolVersion is the version
+// assumed by runners that don't negotiate VersionedPlugins.
 var HandshakeConfig = plugin.HandshakeConfig{
 	ProtocolVersion:  2,
 	MagicCookieKey:   "Switchboard",
 	MagicCookieValue: "Plugin",
 }
+
+// VersionedPlugins returns the plugin sets for every protocol version this SDK speaks, so that
+// go-plugin can negotiate the newest protocol both sides understand. Providers pass their
+// implementation, while the runner passes nil since it only dispenses clients. Either way, the
+// dispensed client is a ProviderV3.
+//
+// Version 3 is the context-aware ProviderV3 protocol. It is served over gRPC, using the service
+// defined in sbproto/provider.proto, when the runner allows plugin.ProtocolGRPC and the provider
+// sets plugin.ServeConfig.GRPCServer, and over net/rpc otherwise. Version 2 is the original
+// net/rpc protocol, served by ProviderPluginV2 for runners that haven't moved to version 3.
+func VersionedPlugins(impl ProviderV3) map[int]plugin.PluginSet {
+	return map[int]plugin.PluginSet{
+		2: {PROVIDER_PLUGIN_NAME: &ProviderPluginV2{Impl: impl}},
+		3: {PROVIDER_PLUGIN_NAME: &ProviderPlugin{Impl: impl}},
+	}
+}
+
+// runnerServer is what the runner serves on the broker stream of one Init call. It can be stopped
+// before the plugin has dialed the stream, as when Init fails, in which case the server is stopped
+// as soon as it starts.
+type runnerServer struct {
+	mu      sync.Mutex
+	stop    func()
+	stopped bool
+}
+
+// serving records how to stop the server once the plugin has dialed it. It reports false, having
+// called stop, when the server was stopped before then.
+func (s *runnerServer) serving(stop func()) bool {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		stop()
+		return false
+	}
+	s.stop = stop
+	s.mu.Unlock()
+	return true
+}
+
+func (s *runnerServer) Stop() {
+	s.mu.Lock()
+	s.stopped = true
+	stop := s.stop
+	s.mu.Unlock()
+	if stop != nil {
+		stop()
+	}
+}
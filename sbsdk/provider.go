@@ -1,6 +1,7 @@
 package sbsdk
 
 import (
+	"context"
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -13,9 +14,9 @@ type Provider interface {
 	//Init is called shortly after loading a plugin, and gives the provider access to certain
 	//data owned by the runner
 	//
-	//It hands the provider an instance of the ContextProvider interface, which will
+	//It hands the provider an instance of the RunnerProvider interface, which will
 	//be implemented by the runner, and various methods should be called to get context/config
-	//information from the runner.
+	//information from the runner. The provider may hold on to it and call it at any time.
 	Init(runnerProvider RunnerProvider) (ProviderConfig, error)
 
 	//InitSchema is used by the CLI to validate user provided Config, and is also used in Init
@@ -62,6 +63,36 @@ type Provider interface {
 	DeleteSubscription(contextId string, subscriptionId string) error
 }
 
+// ProviderV3 is the context-aware form of Provider, and is what the runner talks to over
+// protocol version 3. Each method behaves exactly like its Provider counterpart, but receives
+// the runner's context so that deadlines and cancellation propagate across the plugin boundary
+// into the implementation. Existing Provider implementations can be served with AdaptProvider.
+type ProviderV3 interface {
+	Init(ctx context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error)
+	InitSchema(ctx context.Context) (ObjectSchema, error)
+
+	ActionNames(ctx context.Context) ([]string, error)
+	//ActionEvaluate returns the action's output along with the paths of its sensitive values,
+	//which Provider.ActionEvaluate has no way to report
+	ActionEvaluate(ctx context.Context, contextId string, name string, input []byte) (ActionOutput, error)
+	ActionConfigurationSchema(ctx context.Context, name string) (ObjectSchema, error)
+	ActionOutputType(ctx context.Context, name string) (Type, error)
+	//ActionMetadata describes the named action for help text and documentation
+	ActionMetadata(ctx context.Context, name string) (Metadata, error)
+
+	TriggerKeyNames(ctx context.Context) ([]string, error)
+	TriggerConfigurationSchema(ctx context.Context) (ObjectSchema, error)
+	MapPayloadToTriggerKey(ctx context.Context, payload []byte) (string, error)
+	TriggerOutputType(ctx context.Context, key string) (Type, error)
+	//TriggerMetadata describes the trigger with the given key for help text and documentation
+	TriggerMetadata(ctx context.Context, key string) (Metadata, error)
+
+	CreateSubscription(ctx context.Context, contextId string, input []byte) ([]byte, error)
+	ReadSubscription(ctx context.Context, contextId string, subscriptionId string) ([]byte, error)
+	UpdateSubscription(ctx context.Context, contextId string, subscriptionId string, input []byte) ([]byte, error)
+	DeleteSubscription(ctx context.Context, contextId string, subscriptionId string) error
+}
+
 // Action to be implemented by providers for each individual method/endpoint tied the provider.
 // Each action should be inclusive of all possible ways of calling a method/endpoint for the integration.
 type Action interface {
@@ -75,8 +106,35 @@ type Action interface {
 	// used for helping the calling application know how the hcl Config data should look.
 	OutputType() (Type, error)
 	//Evaluate is the main function called by the runner service when a particular action is being processed. In
-	// a standard integration provider, this is where the guts of integration code will be.
-	Evaluate(contextId string, input cty.Value) (cty.Value, error)
+	// a standard integration provider, this is where the guts of integration code will be. Implementations
+	// should stop work and return when ctx is done.
+	Evaluate(ctx context.Context, contextId string, input cty.Value) (cty.Value, error)
+}
+
+// Trigger to be implemented by providers for each trigger key. A trigger owns everything about
+// one kind of event: how to recognise its payloads, what those payloads look like, and the
+// lifecycle of the vendor subscriptions that deliver them. Triggers are composed into the flat
+// trigger methods of ProviderV3 by a TriggerRegistry.
+type Trigger interface {
+	// ConfigurationSchema returns the schema of the user-provided configuration needed to
+	// subscribe to this trigger.
+	ConfigurationSchema() (ObjectSchema, error)
+	// OutputType provides a schema structure for the payloads matched by this trigger.
+	OutputType() (Type, error)
+	// StateType provides a schema structure for the subscription state returned by
+	// CreateSubscription, ReadSubscription and UpdateSubscription.
+	StateType() (Type, error)
+	// MatchesPayload reports whether an incoming event belongs to this trigger.
+	MatchesPayload(ctx context.Context, payload []byte) (bool, error)
+	// CreateSubscription subscribes to the vendor with input conforming to ConfigurationSchema.
+	// It returns the vendor's id for the subscription along with its current state.
+	CreateSubscription(ctx context.Context, contextId string, input cty.Value) (string, cty.Value, error)
+	// ReadSubscription gets the current state of the subscription from the vendor.
+	ReadSubscription(ctx context.Context, contextId string, subscriptionId string) (cty.Value, error)
+	// UpdateSubscription updates the subscription with new input and returns its new state.
+	UpdateSubscription(ctx context.Context, contextId string, subscriptionId string, input cty.Value) (cty.Value, error)
+	// DeleteSubscription removes the subscription from the vendor.
+	DeleteSubscription(ctx context.Context, contextId string, subscriptionId string) error
 }
 
 // ProviderConfig is some static information the runner can use to decipher how to process certain types
@@ -85,13 +143,24 @@ type ProviderConfig struct {
 	SubscriptionsRegisteredTogether bool
 }
 
+// ActionOutput is the result of ProviderV3.ActionEvaluate. cty JSON can't carry marks, so the
+// paths of the sensitive values in Value travel alongside it, and Decode marks them again.
+type ActionOutput struct {
+	//Value is the output of the action as cty JSON, conforming to its ActionOutputType
+	Value []byte
+	//SensitivePaths are the paths of the values in Value that are marked with SENSITIVE_MARK
+	SensitivePaths []cty.Path
+}
+
 type ActionEvalData struct {
+	CallContext
 	ContextId string
 	Name      string
 	Input     []byte
 }
 
 type SubscriptionData struct {
+	CallContext
 	ContextId      string
 	SubscriptionId string
 	InputData      []byte
@@ -0,0 +1,531 @@
+package sbsdk
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+const (
+	//SB_TAG is the struct tag read by SchemaFor, TypeFor, Decode and Encode. Its value is the
+	//attribute name, optionally followed by comma separated options:
+	//  required  - the attribute must be set, and is not optional in the derived Type
+	//  sensitive - the attribute is Sensitive
+	//  block     - SchemaFor declares a block rather than an attribute. Only valid on struct,
+	//              pointer to struct and slice of struct fields.
+	//Fields without the tag, or tagged "-", are ignored, except that the fields of an untagged
+	//embedded struct are treated as fields of the outer struct.
+	SB_TAG = "sb"
+
+	TAG_OPTION_REQUIRED  = "required"
+	TAG_OPTION_SENSITIVE = "sensitive"
+	TAG_OPTION_BLOCK     = "block"
+)
+
+var ctyValueType = reflect.TypeOf(cty.Value{})
+
+// structField is a struct field tagged with SB_TAG.
+type structField struct {
+	name      string
+	index     []int
+	fieldType reflect.Type
+	required  bool
+	sensitive bool
+	block     bool
+}
+
+// SchemaFor derives an ObjectSchema from the SB_TAG tags of the struct type T, so that an
+// action's configuration can be declared by the Go struct it is decoded into with Decode.
+// Nested struct fields are object attributes unless they are tagged as blocks.
+func SchemaFor[T any]() (ObjectSchema, error) {
+	return schemaOf(reflect.TypeOf((*T)(nil)).Elem(), map[reflect.Type]bool{})
+}
+
+// TypeFor derives the Type of values of T. Strings, bools and numeric kinds are primitives,
+// slices and arrays are lists, maps with string keys are maps, cty.Value is Dynamic and structs
+// are objects whose attributes are declared by SB_TAG tags. Attributes not tagged required are
+// optional, and pointers may be null.
+func TypeFor[T any]() (Type, error) {
+	return typeOf(reflect.TypeOf((*T)(nil)).Elem(), map[reflect.Type]bool{})
+}
+
+// Decode copies val into a new T, following the same mapping as TypeFor. Null values decode to
+// the zero value, and cty.Value fields receive their value as is, marks included. An error is
+// returned if val contains unknown values, or values that can't be converted to their field.
+func Decode[T any](val cty.Value) (T, error) {
+	var out T
+	err := decodeValue(val, reflect.ValueOf(&out).Elem(), nil)
+	if err != nil {
+		return out, valueDiagnostics("Value cannot be decoded", err)
+	}
+	return out, nil
+}
+
+// Encode converts v into a cty.Value of the type derived by TypeFor, with its sensitive
+// attributes marked.
+func Encode[T any](v T) (cty.Value, error) {
+	rv := reflect.ValueOf(&v).Elem()
+	t, err := typeOf(rv.Type(), map[reflect.Type]bool{})
+	if err != nil {
+		return cty.NilVal, err
+	}
+	val, err := encodeValue(rv, t.ToCty().WithoutOptionalAttributesDeep(), nil)
+	if err != nil {
+		return cty.NilVal, valueDiagnostics("Value cannot be encoded", err)
+	}
+	return markSensitive(val, t), nil
+}
+
+// structFields returns the tagged fields of the struct type t, including those of untagged
+// embedded structs.
+func structFields(t reflect.Type) ([]structField, error) {
+	var out []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup(SB_TAG)
+		if !ok {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if f.Anonymous && embedded.Kind() == reflect.Struct {
+				nested, err := structFields(embedded)
+				if err != nil {
+					return nil, err
+				}
+				for _, n := range nested {
+					n.index = append([]int{i}, n.index...)
+					out = append(out, n)
+				}
+			}
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fmt.Errorf("field %s of %s is tagged but not exported", f.Name, t)
+		}
+		parts := strings.Split(tag, ",")
+		field := structField{
+			name:      parts[0],
+			index:     []int{i},
+			fieldType: f.Type,
+		}
+		if field.name == "" {
+			return nil, fmt.Errorf("field %s of %s has no attribute name in its %s tag", f.Name, t, SB_TAG)
+		}
+		for _, option := range parts[1:] {
+			switch option {
+			case TAG_OPTION_REQUIRED:
+				field.required = true
+			case TAG_OPTION_SENSITIVE:
+				field.sensitive = true
+			case TAG_OPTION_BLOCK:
+				field.block = true
+			default:
+				return nil, fmt.Errorf("field %s of %s has unknown %s tag option %q", f.Name, t, SB_TAG, option)
+			}
+		}
+		out = append(out, field)
+	}
+	seen := map[string]bool{}
+	for _, field := range out {
+		if seen[field.name] {
+			return nil, fmt.Errorf("%s has more than one field named %q", t, field.name)
+		}
+		seen[field.name] = true
+	}
+	return out, nil
+}
+
+// schemaOf derives the schema of the struct type t. visiting is shared with typeOf.
+func schemaOf(t reflect.Type, visiting map[reflect.Type]bool) (ObjectSchema, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return ObjectSchema{}, fmt.Errorf("cannot derive a schema from %s, which is not a struct", t)
+	}
+	if visiting[t] {
+		return ObjectSchema{}, fmt.Errorf("cannot derive a schema from %s, which contains itself", t)
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+	fields, err := structFields(t)
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	out := ObjectSchema{Attributes: map[string]Schema{}}
+	for _, field := range fields {
+		if !field.block {
+			attrType, err := typeOf(field.fieldType, visiting)
+			if err != nil {
+				return ObjectSchema{}, err
+			}
+			out.Attributes[field.name] = &AttrSchema{
+				Name:      field.name,
+				Required:  field.required,
+				Type:      attrType,
+				Sensitive: field.sensitive,
+			}
+			continue
+		}
+		if field.sensitive {
+			return ObjectSchema{}, fmt.Errorf("block %q of %s cannot be sensitive", field.name, t)
+		}
+		blockType := field.fieldType
+		mode := BLOCK_MODE_SINGLE
+		if blockType.Kind() == reflect.Slice {
+			blockType = blockType.Elem()
+			mode = BLOCK_MODE_LIST
+		}
+		if blockType.Kind() == reflect.Pointer {
+			blockType = blockType.Elem()
+		}
+		if blockType.Kind() != reflect.Struct {
+			return ObjectSchema{}, fmt.Errorf("block %q of %s must be a struct, or a slice of structs", field.name, t)
+		}
+		nested, err := schemaOf(blockType, visiting)
+		if err != nil {
+			return ObjectSchema{}, err
+		}
+		out.Attributes[field.name] = &BlockSchema{
+			Name:     field.name,
+			Required: field.required,
+			Nested:   &nested,
+			Mode:     mode,
+		}
+	}
+	return out, nil
+}
+
+// typeOf derives the Type of t. visiting holds the structs being derived, so that recursive
+// types are reported rather than followed forever.
+func typeOf(t reflect.Type, visiting map[reflect.Type]bool) (Type, error) {
+	if t == ctyValueType {
+		return Dynamic, nil
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return typeOf(t.Elem(), visiting)
+	case reflect.String:
+		return String, nil
+	case reflect.Bool:
+		return Bool, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return Number, nil
+	case reflect.Slice, reflect.Array:
+		elementType, err := typeOf(t.Elem(), visiting)
+		if err != nil {
+			return Invalid, err
+		}
+		return List(elementType), nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return Invalid, fmt.Errorf("cannot derive a type from %s, whose keys are not strings", t)
+		}
+		elementType, err := typeOf(t.Elem(), visiting)
+		if err != nil {
+			return Invalid, err
+		}
+		return Map(elementType), nil
+	case reflect.Struct:
+		if visiting[t] {
+			return Invalid, fmt.Errorf("cannot derive a type from %s, which contains itself", t)
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		fields, err := structFields(t)
+		if err != nil {
+			return Invalid, err
+		}
+		attrs := map[string]Type{}
+		var optional []string
+		for _, field := range fields {
+			attrType, err := typeOf(field.fieldType, visiting)
+			if err != nil {
+				return Invalid, err
+			}
+			if field.sensitive {
+				attrType.Sensitive = true
+			}
+			attrs[field.name] = attrType
+			if !field.required {
+				optional = append(optional, field.name)
+			}
+		}
+		if len(optional) == 0 {
+			return Object(attrs), nil
+		}
+		return ObjectWithOptionalAttrs(attrs, optional...), nil
+	default:
+		return Invalid, fmt.Errorf("cannot derive a type from %s", t)
+	}
+}
+
+func decodeValue(val cty.Value, dst reflect.Value, path cty.Path) error {
+	if dst.Type() == ctyValueType {
+		dst.Set(reflect.ValueOf(val))
+		return nil
+	}
+	val, _ = val.Unmark()
+	if !val.IsKnown() {
+		return path.NewErrorf("value must be known")
+	}
+	if val.IsNull() {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	switch dst.Kind() {
+	case reflect.Pointer:
+		ptr := reflect.New(dst.Type().Elem())
+		err := decodeValue(val, ptr.Elem(), path)
+		if err != nil {
+			return err
+		}
+		dst.Set(ptr)
+		return nil
+	case reflect.String:
+		str, err := convert.Convert(val, cty.String)
+		if err != nil {
+			return path.NewError(err)
+		}
+		dst.SetString(str.AsString())
+		return nil
+	case reflect.Bool:
+		b, err := convert.Convert(val, cty.Bool)
+		if err != nil {
+			return path.NewError(err)
+		}
+		dst.SetBool(b.True())
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		num, err := decodeNumber(val, path)
+		if err != nil {
+			return err
+		}
+		i, accuracy := num.Int64()
+		if accuracy != big.Exact || dst.OverflowInt(i) {
+			return path.NewErrorf("%s cannot be represented as %s", num.Text('f', -1), dst.Type())
+		}
+		dst.SetInt(i)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		num, err := decodeNumber(val, path)
+		if err != nil {
+			return err
+		}
+		u, accuracy := num.Uint64()
+		if accuracy != big.Exact || dst.OverflowUint(u) {
+			return path.NewErrorf("%s cannot be represented as %s", num.Text('f', -1), dst.Type())
+		}
+		dst.SetUint(u)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		num, err := decodeNumber(val, path)
+		if err != nil {
+			return err
+		}
+		f, _ := num.Float64()
+		if dst.OverflowFloat(f) {
+			return path.NewErrorf("%s cannot be represented as %s", num.Text('f', -1), dst.Type())
+		}
+		dst.SetFloat(f)
+		return nil
+	case reflect.Slice, reflect.Array:
+		if !val.CanIterateElements() || val.Type().IsMapType() || val.Type().IsObjectType() {
+			return path.NewErrorf("a list is required, not %s", val.Type().FriendlyName())
+		}
+		length := val.LengthInt()
+		if dst.Kind() == reflect.Array {
+			if length != dst.Len() {
+				return path.NewErrorf("exactly %d elements are required, not %d", dst.Len(), length)
+			}
+		} else {
+			dst.Set(reflect.MakeSlice(dst.Type(), length, length))
+		}
+		iter := val.ElementIterator()
+		for i := 0; iter.Next(); i++ {
+			k, el := iter.Element()
+			err := decodeValue(el, dst.Index(i), path.Index(k))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		if !val.Type().IsMapType() && !val.Type().IsObjectType() {
+			return path.NewErrorf("a map is required, not %s", val.Type().FriendlyName())
+		}
+		out := reflect.MakeMapWithSize(dst.Type(), val.LengthInt())
+		for k, el := range val.AsValueMap() {
+			elVal := reflect.New(dst.Type().Elem()).Elem()
+			err := decodeValue(el, elVal, path.Index(cty.StringVal(k)))
+			if err != nil {
+				return err
+			}
+			out.SetMapIndex(reflect.ValueOf(k).Convert(dst.Type().Key()), elVal)
+		}
+		dst.Set(out)
+		return nil
+	case reflect.Struct:
+		if !val.Type().IsObjectType() {
+			return path.NewErrorf("an object is required, not %s", val.Type().FriendlyName())
+		}
+		fields, err := structFields(dst.Type())
+		if err != nil {
+			return err
+		}
+		for _, field := range fields {
+			if !val.Type().HasAttribute(field.name) {
+				continue
+			}
+			fieldVal, err := fieldByIndex(dst, field.index)
+			if err != nil {
+				return err
+			}
+			err = decodeValue(val.GetAttr(field.name), fieldVal, path.GetAttr(field.name))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return path.NewErrorf("cannot decode into %s", dst.Type())
+	}
+}
+
+func decodeNumber(val cty.Value, path cty.Path) (*big.Float, error) {
+	num, err := convert.Convert(val, cty.Number)
+	if err != nil {
+		return nil, path.NewError(err)
+	}
+	return num.AsBigFloat(), nil
+}
+
+// fieldByIndex is reflect.Value.FieldByIndex, except that nil embedded struct pointers are
+// allocated rather than causing a panic.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot set embedded pointer to unexported struct %s", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+func encodeValue(rv reflect.Value, ty cty.Type, path cty.Path) (cty.Value, error) {
+	if rv.Type() == ctyValueType {
+		val := rv.Interface().(cty.Value)
+		if val == cty.NilVal {
+			return cty.NullVal(cty.DynamicPseudoType), nil
+		}
+		return val, nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return cty.NullVal(ty), nil
+		}
+		return encodeValue(rv.Elem(), ty, path)
+	case reflect.String:
+		return cty.StringVal(rv.String()), nil
+	case reflect.Bool:
+		return cty.BoolVal(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cty.NumberIntVal(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cty.NumberUIntVal(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return cty.NumberFloatVal(rv.Float()), nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return cty.NullVal(ty), nil
+		}
+		if rv.Len() == 0 {
+			return cty.ListValEmpty(ty.ElementType()), nil
+		}
+		elements := make([]cty.Value, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			el, err := encodeValue(rv.Index(i), ty.ElementType(), path.Index(cty.NumberIntVal(int64(i))))
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elements = append(elements, el)
+		}
+		if err := checkElementTypes(elements, path); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.ListVal(elements), nil
+	case reflect.Map:
+		if rv.IsNil() {
+			return cty.NullVal(ty), nil
+		}
+		if rv.Len() == 0 {
+			return cty.MapValEmpty(ty.ElementType()), nil
+		}
+		elements := make(map[string]cty.Value, rv.Len())
+		var list []cty.Value
+		iter := rv.MapRange()
+		for iter.Next() {
+			k := iter.Key().String()
+			el, err := encodeValue(iter.Value(), ty.ElementType(), path.Index(cty.StringVal(k)))
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elements[k] = el
+			list = append(list, el)
+		}
+		if err := checkElementTypes(list, path); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.MapVal(elements), nil
+	case reflect.Struct:
+		fields, err := structFields(rv.Type())
+		if err != nil {
+			return cty.NilVal, err
+		}
+		attrs := make(map[string]cty.Value, len(fields))
+		for _, field := range fields {
+			fieldVal, err := rv.FieldByIndexErr(field.index)
+			if err != nil {
+				attrs[field.name] = cty.NullVal(ty.AttributeType(field.name))
+				continue
+			}
+			attr, err := encodeValue(fieldVal, ty.AttributeType(field.name), path.GetAttr(field.name))
+			if err != nil {
+				return cty.NilVal, err
+			}
+			attrs[field.name] = attr
+		}
+		return cty.ObjectVal(attrs), nil
+	default:
+		return cty.NilVal, path.NewErrorf("cannot encode %s", rv.Type())
+	}
+}
+
+// checkElementTypes returns an error if elements, which are encoded from a Go slice or map, don't
+// all have the same type. This can only happen when the elements are cty.Values.
+func checkElementTypes(elements []cty.Value, path cty.Path) error {
+	for _, el := range elements[1:] {
+		if !el.Type().Equals(elements[0].Type()) {
+			return path.NewErrorf("elements must all have the same type, not both %s and %s",
+				elements[0].Type().FriendlyName(), el.Type().FriendlyName())
+		}
+	}
+	return nil
+}
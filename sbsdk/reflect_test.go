@@ -0,0 +1,231 @@
+package sbsdk
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+type reflectAddress struct {
+	Street string `sb:"street,required"`
+	Zip    *int   `sb:"zip"`
+}
+
+type reflectAudit struct {
+	CreatedBy string `sb:"created_by"`
+}
+
+type reflectUser struct {
+	reflectAudit
+	Name      string            `sb:"name,required"`
+	ApiKey    string            `sb:"api_key,required,sensitive"`
+	Tags      []string          `sb:"tags"`
+	Labels    map[string]string `sb:"labels"`
+	Extra     cty.Value         `sb:"extra"`
+	Address   reflectAddress    `sb:"address,block"`
+	Contacts  []reflectAddress  `sb:"contact,block"`
+	Ignored   string            `sb:"-"`
+	Untagged  string
+	Nicknames []string `sb:"nicknames"`
+}
+
+func TestSchemaFor(t *testing.T) {
+	schema, err := SchemaFor[reflectUser]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	attr := func(name string) *AttrSchema {
+		t.Helper()
+		s, ok := schema.Attributes[name].(*AttrSchema)
+		if !ok {
+			t.Fatalf("got %#v for %q, want an attribute", schema.Attributes[name], name)
+		}
+		return s
+	}
+	if s := attr("name"); !s.Required || s.Sensitive || !reflect.DeepEqual(s.Type, String) {
+		t.Errorf("got name %#v", s)
+	}
+	if s := attr("api_key"); !s.Required || !s.Sensitive {
+		t.Errorf("got api_key %#v", s)
+	}
+	if s := attr("created_by"); s.Required {
+		t.Errorf("got created_by from the embedded struct %#v", s)
+	}
+	if s := attr("extra"); !reflect.DeepEqual(s.Type, Dynamic) {
+		t.Errorf("got extra of type %s, want dynamic", s.Type.ToCty().FriendlyName())
+	}
+	for _, name := range []string{"Ignored", "Untagged", "-"} {
+		if _, ok := schema.Attributes[name]; ok {
+			t.Errorf("got an attribute for the untagged field %q", name)
+		}
+	}
+	address, ok := schema.Attributes["address"].(*BlockSchema)
+	if !ok || address.Mode != BLOCK_MODE_SINGLE {
+		t.Fatalf("got address %#v, want a single block", schema.Attributes["address"])
+	}
+	street, ok := address.Nested.(*ObjectSchema).Attributes["street"].(*AttrSchema)
+	if !ok || !street.Required {
+		t.Errorf("got street %#v", street)
+	}
+	if contact, ok := schema.Attributes["contact"].(*BlockSchema); !ok || contact.Mode != BLOCK_MODE_LIST {
+		t.Errorf("got contact %#v, want a list block", schema.Attributes["contact"])
+	}
+	if diags := CheckSchema(&schema); diags.HasErrors() {
+		t.Errorf("derived schema is invalid: %s", diags.Error())
+	}
+}
+
+func TestTypeForMakesUntaggedAttributesOptional(t *testing.T) {
+	got, err := TypeFor[reflectAddress]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ObjectWithOptionalAttrs(map[string]Type{"street": String, "zip": Number}, "zip")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestReflectionErrors(t *testing.T) {
+	type recursive struct {
+		Next *recursive `sb:"next"`
+	}
+	type unknownOption struct {
+		Name string `sb:"name,secret"`
+	}
+	type duplicate struct {
+		A string `sb:"name"`
+		B string `sb:"name"`
+	}
+	type unexported struct {
+		name string `sb:"name"`
+	}
+	type sensitiveBlock struct {
+		Address reflectAddress `sb:"address,block,sensitive"`
+	}
+	type badKeys struct {
+		Counts map[int]string `sb:"counts"`
+	}
+	tests := map[string]struct {
+		derive func() error
+		want   string
+	}{
+		"recursive": {
+			derive: func() error { _, err := TypeFor[recursive](); return err },
+			want:   "contains itself",
+		},
+		"unknown option": {
+			derive: func() error { _, err := SchemaFor[unknownOption](); return err },
+			want:   `unknown sb tag option "secret"`,
+		},
+		"duplicate": {
+			derive: func() error { _, err := SchemaFor[duplicate](); return err },
+			want:   `more than one field named "name"`,
+		},
+		"unexported": {
+			derive: func() error { _, err := SchemaFor[unexported](); return err },
+			want:   "tagged but not exported",
+		},
+		"sensitive block": {
+			derive: func() error { _, err := SchemaFor[sensitiveBlock](); return err },
+			want:   "cannot be sensitive",
+		},
+		"map keys": {
+			derive: func() error { _, err := TypeFor[badKeys](); return err },
+			want:   "keys are not strings",
+		},
+		"not a struct": {
+			derive: func() error { _, err := SchemaFor[string](); return err },
+			want:   "not a struct",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.derive()
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got error %v, want one containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeAndEncode(t *testing.T) {
+	zip := 12345
+	user := reflectUser{
+		reflectAudit: reflectAudit{CreatedBy: "admin"},
+		Name:         "ada",
+		ApiKey:       "hunter2",
+		Tags:         []string{"a", "b"},
+		Labels:       map[string]string{"team": "core"},
+		Extra:        cty.NumberIntVal(1),
+		Address:      reflectAddress{Street: "Main St", Zip: &zip},
+		Contacts:     []reflectAddress{{Street: "Side St"}},
+		Nicknames:    []string{},
+	}
+	val, err := Encode(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSensitive(val.GetAttr("api_key")) || IsSensitive(val.GetAttr("name")) {
+		t.Errorf("got marks %#v", val)
+	}
+	if !val.GetAttr("contact").Index(cty.NumberIntVal(0)).GetAttr("zip").IsNull() {
+		t.Errorf("got zip %#v for a nil pointer, want null", val.GetAttr("contact"))
+	}
+	got, err := Decode[reflectUser](val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, user) {
+		t.Errorf("got %#v, want %#v", got, user)
+	}
+}
+
+func TestDecodeInput(t *testing.T) {
+	schema, err := SchemaFor[reflectAddress]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	val, err := MapInputToCtyValue([]byte(`{"street":"Main St","zip":null}`), schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Decode[reflectAddress](val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Street != "Main St" || got.Zip != nil {
+		t.Errorf("got %#v", got)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := map[string]struct {
+		val  cty.Value
+		path cty.Path
+	}{
+		"unknown": {
+			val:  cty.ObjectVal(map[string]cty.Value{"street": cty.UnknownVal(cty.String)}),
+			path: cty.GetAttrPath("street"),
+		},
+		"overflow": {
+			val:  cty.ObjectVal(map[string]cty.Value{"street": cty.StringVal("a"), "zip": cty.NumberFloatVal(1.5)}),
+			path: cty.GetAttrPath("zip"),
+		},
+		"wrong type": {
+			val:  cty.StringVal("Main St"),
+			path: cty.Path{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Decode[reflectAddress](test.val)
+			if err == nil {
+				t.Fatal("got no error")
+			}
+			assertDiagnosticPath(t, err, test.path)
+		})
+	}
+}
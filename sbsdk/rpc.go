@@ -1,277 +1,660 @@
 package sbsdk
 
 import (
+	"context"
 	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"github.com/hashicorp/go-plugin"
 	"net/rpc"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
+// CallContext is embedded in every net/rpc payload so that the runner's context survives the trip
+// into the plugin. The plugin derives a context with the same Deadline for the call, and cancels it
+// when the runner sends Plugin.Cancel with the same RequestId.
+type CallContext struct {
+	RequestId uint64
+	Deadline  time.Time
+}
+
 type ProviderRPCClient struct {
-	client *rpc.Client
+	client        *rpc.Client
+	broker        *plugin.MuxBroker
+	nextRequestId uint64
+
+	//mu guards runnerServer, the server of the RunnerProvider passed to the latest successful Init
+	mu           sync.Mutex
+	runnerServer *runnerServer
 }
 
-func NewProviderRPCClient() Provider {
+func NewProviderRPCClient() ProviderV3 {
 	return &ProviderRPCClient{}
 }
 
-func (p *ProviderRPCClient) Init(runnerProvider RunnerProvider) (ProviderConfig, error) {
+// Init serves runnerProvider on a new broker stream and tells the plugin which stream
+// to dial, so that the plugin can call back into the runner for as long as it runs.
+// Once Init succeeds, the stream served for an earlier Init is closed, and when it fails,
+// the new stream is closed instead.
+func (p *ProviderRPCClient) Init(ctx context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error) {
 	var result ProviderConfig
-	err := p.client.Call("Plugin.Init", runnerProvider, &result)
+	brokerId := p.broker.NextId()
+	server := &runnerServer{}
+	go p.serveRunnerProvider(brokerId, runnerProvider, server)
+	payload := InitData{
+		CallContext:    p.callContext(ctx),
+		RunnerBrokerId: brokerId,
+	}
+	err := p.call(ctx, "Plugin.Init", payload, &result)
 	if err != nil {
-		panic(err)
+		server.Stop()
+		return ProviderConfig{}, err
+	}
+	p.mu.Lock()
+	previous := p.runnerServer
+	p.runnerServer = server
+	p.mu.Unlock()
+	if previous != nil {
+		previous.Stop()
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) InitSchema() (ObjectSchema, error) {
-	var result ObjectSchema
-	err := p.client.Call("Plugin.InitSchema", new(interface{}), &result)
+// serveRunnerProvider serves runnerProvider on the broker stream brokerId once the plugin has
+// dialed it, until server is stopped. When the plugin never dials, as when Init fails before it
+// does, broker.Accept gives up after the broker's accept timeout.
+func (p *ProviderRPCClient) serveRunnerProvider(brokerId uint32, runnerProvider RunnerProviderV3, server *runnerServer) {
+	conn, err := p.broker.Accept(brokerId)
+	if err != nil {
+		return
+	}
+	rpcServer := rpc.NewServer()
+	if err := rpcServer.RegisterName("Plugin", &RunnerProviderRPCServer{Impl: runnerProvider}); err != nil {
+		conn.Close()
+		return
+	}
+	if !server.serving(func() { conn.Close() }) {
+		return
+	}
+	rpcServer.ServeConn(conn)
+}
 
+func (p *ProviderRPCClient) InitSchema(ctx context.Context) (ObjectSchema, error) {
+	var result ObjectSchema
+	err := p.call(ctx, "Plugin.InitSchema", p.callContext(ctx), &result)
 	if err != nil {
-		panic(err)
+		return ObjectSchema{}, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) MapPayloadToTriggerKey(data []byte) (string, error) {
+func (p *ProviderRPCClient) MapPayloadToTriggerKey(ctx context.Context, data []byte) (string, error) {
 	var result string
-	err := p.client.Call("Plugin.MapPayloadToTriggerKey", data, &result)
+	payload := PayloadData{
+		CallContext: p.callContext(ctx),
+		Payload:     data,
+	}
+	err := p.call(ctx, "Plugin.MapPayloadToTriggerKey", payload, &result)
 	if err != nil {
 		return "", err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) ActionNames() ([]string, error) {
+func (p *ProviderRPCClient) ActionNames(ctx context.Context) ([]string, error) {
 	var result []string
-	err := p.client.Call("Plugin.ActionNames", new(interface{}), &result)
+	err := p.call(ctx, "Plugin.ActionNames", p.callContext(ctx), &result)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) ActionConfigurationSchema(name string) (ObjectSchema, error) {
+func (p *ProviderRPCClient) ActionConfigurationSchema(ctx context.Context, name string) (ObjectSchema, error) {
 	var result ObjectSchema
-	err := p.client.Call("Plugin.ActionConfigurationSchema", name, &result)
+	payload := NameData{
+		CallContext: p.callContext(ctx),
+		Name:        name,
+	}
+	err := p.call(ctx, "Plugin.ActionConfigurationSchema", payload, &result)
 	if err != nil {
 		return ObjectSchema{}, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) ActionOutputType(name string) (Type, error) {
+func (p *ProviderRPCClient) ActionOutputType(ctx context.Context, name string) (Type, error) {
 	var result Type
-	err := p.client.Call("Plugin.ActionOutputType", name, &result)
+	payload := NameData{
+		CallContext: p.callContext(ctx),
+		Name:        name,
+	}
+	err := p.call(ctx, "Plugin.ActionOutputType", payload, &result)
 	if err != nil {
 		return Type{}, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) ActionEvaluate(contextId string, name string, input []byte) ([]byte, error) {
-	var result []byte
-	payload := ActionEvalData{
-		ContextId: contextId,
-		Name:      name,
-		Input:     input,
+func (p *ProviderRPCClient) ActionMetadata(ctx context.Context, name string) (Metadata, error) {
+	var result Metadata
+	payload := NameData{
+		CallContext: p.callContext(ctx),
+		Name:        name,
 	}
-	err := p.client.Call("Plugin.ActionEvaluate", payload, &result)
+	err := p.call(ctx, "Plugin.ActionMetadata", payload, &result)
 	if err != nil {
-		return nil, err
+		return Metadata{}, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) TriggerKeyNames() ([]string, error) {
+func (p *ProviderRPCClient) ActionEvaluate(ctx context.Context, contextId string, name string, input []byte) (ActionOutput, error) {
+	var result ActionOutputData
+	payload := ActionEvalData{
+		CallContext: p.callContext(ctx),
+		ContextId:   contextId,
+		Name:        name,
+		Input:       input,
+	}
+	err := p.call(ctx, "Plugin.ActionEvaluate", payload, &result)
+	if err != nil {
+		return ActionOutput{}, err
+	}
+	return result.actionOutput(), nil
+}
+
+func (p *ProviderRPCClient) TriggerKeyNames(ctx context.Context) ([]string, error) {
 	var result []string
-	err := p.client.Call("Plugin.TriggerKeyNames", nil, &result)
+	err := p.call(ctx, "Plugin.TriggerKeyNames", p.callContext(ctx), &result)
 	if err != nil {
 		return []string{}, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) TriggerConfigurationSchema() (ObjectSchema, error) {
+func (p *ProviderRPCClient) TriggerConfigurationSchema(ctx context.Context) (ObjectSchema, error) {
 	var result ObjectSchema
-	err := p.client.Call("Plugin.TriggerConfigurationSchema", nil, &result)
+	err := p.call(ctx, "Plugin.TriggerConfigurationSchema", p.callContext(ctx), &result)
 	if err != nil {
 		return ObjectSchema{}, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) TriggerOutputType(name string) (Type, error) {
+func (p *ProviderRPCClient) TriggerOutputType(ctx context.Context, name string) (Type, error) {
 	var result Type
-	err := p.client.Call("Plugin.TriggerOutputType", name, &result)
+	payload := NameData{
+		CallContext: p.callContext(ctx),
+		Name:        name,
+	}
+	err := p.call(ctx, "Plugin.TriggerOutputType", payload, &result)
 	if err != nil {
 		return Type{}, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) CreateSubscription(contextId string, input []byte) ([]byte, error) {
+func (p *ProviderRPCClient) TriggerMetadata(ctx context.Context, name string) (Metadata, error) {
+	var result Metadata
+	payload := NameData{
+		CallContext: p.callContext(ctx),
+		Name:        name,
+	}
+	err := p.call(ctx, "Plugin.TriggerMetadata", payload, &result)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClient) CreateSubscription(ctx context.Context, contextId string, input []byte) ([]byte, error) {
 	var result []byte
 	payload := SubscriptionData{
-		ContextId: contextId,
-		InputData: input,
+		CallContext: p.callContext(ctx),
+		ContextId:   contextId,
+		InputData:   input,
 	}
-	err := p.client.Call("Plugin.CreateSubscription", payload, &result)
+	err := p.call(ctx, "Plugin.CreateSubscription", payload, &result)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) ReadSubscription(contextId string, subscriptionId string) ([]byte, error) {
+func (p *ProviderRPCClient) ReadSubscription(ctx context.Context, contextId string, subscriptionId string) ([]byte, error) {
 	var result []byte
 	payload := SubscriptionData{
+		CallContext:    p.callContext(ctx),
 		ContextId:      contextId,
 		SubscriptionId: subscriptionId,
 	}
-	err := p.client.Call("Plugin.ReadSubscription", payload, &result)
+	err := p.call(ctx, "Plugin.ReadSubscription", payload, &result)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) UpdateSubscription(contextId string, subscriptionId string, input []byte) ([]byte, error) {
+func (p *ProviderRPCClient) UpdateSubscription(ctx context.Context, contextId string, subscriptionId string, input []byte) ([]byte, error) {
 	var result []byte
 	payload := SubscriptionData{
+		CallContext:    p.callContext(ctx),
 		ContextId:      contextId,
 		SubscriptionId: subscriptionId,
 		InputData:      input,
 	}
-	err := p.client.Call("Plugin.UpdateSubscription", payload, &result)
+	err := p.call(ctx, "Plugin.UpdateSubscription", payload, &result)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-func (p *ProviderRPCClient) DeleteSubscription(contextId string, subscriptionId string) error {
+func (p *ProviderRPCClient) DeleteSubscription(ctx context.Context, contextId string, subscriptionId string) error {
 	var result []byte
 	payload := SubscriptionData{
+		CallContext:    p.callContext(ctx),
 		ContextId:      contextId,
 		SubscriptionId: subscriptionId,
 	}
-	err := p.client.Call("Plugin.DeleteSubscription", payload, &result)
+	err := p.call(ctx, "Plugin.DeleteSubscription", payload, &result)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type ProviderRPCServer struct {
-	Impl Provider
+// callContext allocates a new request id and captures the deadline of ctx, if any.
+func (p *ProviderRPCClient) callContext(ctx context.Context) CallContext {
+	deadline, _ := ctx.Deadline()
+	return CallContext{
+		RequestId: atomic.AddUint64(&p.nextRequestId, 1),
+		Deadline:  deadline,
+	}
 }
 
+// call makes an RPC call that returns as soon as ctx is done. When that happens, the plugin is
+// told to cancel the call's context so the implementation can stop its work too.
+func (p *ProviderRPCClient) call(ctx context.Context, method string, args interface{ callContext() CallContext }, reply interface{}) error {
+	call := p.client.Go(method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		return diagnosticsFromRPCError(call.Error)
+	case <-ctx.Done():
+		p.client.Go("Plugin.Cancel", args.callContext().RequestId, new(struct{}), nil)
+		return ctx.Err()
+	}
+}
+
+// rpcDiagnosticsPrefix marks net/rpc errors whose message is JSON encoded Diagnostics. net/rpc
+// only carries error strings, so this is how diagnostics cross the plugin boundary intact.
+const rpcDiagnosticsPrefix = "sbsdk.Diagnostics:"
+
+// rpcError encodes an error returned by a provider so that diagnosticsFromRPCError can rebuild
+// it on the runner side.
+func rpcError(err error) error {
+	data, jsonErr := json.Marshal(DiagnosticsFromError(err))
+	if jsonErr != nil {
+		return err
+	}
+	return errors.New(rpcDiagnosticsPrefix + string(data))
+}
+
+func diagnosticsFromRPCError(err error) error {
+	serverErr, ok := err.(rpc.ServerError)
+	if !ok || !strings.HasPrefix(string(serverErr), rpcDiagnosticsPrefix) {
+		return err
+	}
+	var diags Diagnostics
+	jsonErr := json.Unmarshal([]byte(strings.TrimPrefix(string(serverErr), rpcDiagnosticsPrefix)), &diags)
+	if jsonErr != nil {
+		return err
+	}
+	return diags
+}
+
+type ProviderRPCServer struct {
+	Impl   ProviderV3
+	broker *plugin.MuxBroker
+
+	//mu guards inflight, cancelled and runnerClient, the client of the RunnerProvider passed to
+	//the latest successful Init
+	mu       sync.Mutex
+	inflight map[uint64]context.CancelFunc
+	//cancelled holds the request ids cancelled before their call was registered, and when the
+	//cancellation arrived
+	cancelled    map[uint64]time.Time
+	runnerClient *rpc.Client
+}
+
+// cancelTombstoneTTL is how long ProviderRPCServer remembers a cancellation that arrived before
+// its call. net/rpc serves requests concurrently, so Plugin.Cancel can overtake the call it
+// cancels, while a cancellation for a call that already finished is never claimed.
+const cancelTombstoneTTL = time.Minute
+
+// InitData is sent by the runner on Init. RunnerBrokerId is the broker stream
+// on which the runner serves its RunnerProvider implementation.
 type InitData struct {
-	GlobalConfig GlobalConfig
-	UserConfig   []byte
+	CallContext
+	RunnerBrokerId uint32
+}
+
+// NameData is the payload for calls that look up an action or trigger by name.
+type NameData struct {
+	CallContext
+	Name string
 }
 
-func (p *ProviderRPCServer) Init(runnerProvider RunnerProvider, reply *ProviderConfig) error {
-	result, err := p.Impl.Init(runnerProvider)
+// PayloadData is the payload for calls that inspect an incoming event.
+type PayloadData struct {
+	CallContext
+	Payload []byte
+}
+
+// ActionOutputData is the reply of ActionEvaluate. cty.Path can't be gob encoded, so the
+// sensitive paths are carried in the same form as the attribute paths of diagnostics.
+type ActionOutputData struct {
+	Output         []byte
+	SensitivePaths [][]attributeStepJSON
+}
+
+func actionOutputToData(output ActionOutput) ActionOutputData {
+	data := ActionOutputData{Output: output.Value}
+	for _, path := range output.SensitivePaths {
+		data.SensitivePaths = append(data.SensitivePaths, attributePathToJSON(path))
+	}
+	return data
+}
+
+func (d ActionOutputData) actionOutput() ActionOutput {
+	output := ActionOutput{Value: d.Output}
+	for _, steps := range d.SensitivePaths {
+		output.SensitivePaths = append(output.SensitivePaths, attributePathFromJSON(steps))
+	}
+	return output
+}
+
+func (c CallContext) callContext() CallContext {
+	return c
+}
+
+// Cancel cancels the context of an in-flight call. The cancellation of a call that hasn't been
+// registered yet is remembered for cancelTombstoneTTL, so that the call is cancelled as soon as
+// it starts, rather than running after the runner has given up on it.
+func (p *ProviderRPCServer) Cancel(requestId uint64, _ *struct{}) error {
+	p.mu.Lock()
+	cancel, ok := p.inflight[requestId]
+	if !ok {
+		now := time.Now()
+		if p.cancelled == nil {
+			p.cancelled = make(map[uint64]time.Time)
+		}
+		for id, at := range p.cancelled {
+			if now.Sub(at) > cancelTombstoneTTL {
+				delete(p.cancelled, id)
+			}
+		}
+		p.cancelled[requestId] = now
+	}
+	p.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	return nil
+}
+
+// context builds the context an implementation runs with for one call. The returned function
+// must be called once the call is done.
+func (p *ProviderRPCServer) context(callCtx CallContext) (context.Context, func()) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if callCtx.Deadline.IsZero() {
+		ctx, cancel = context.WithCancel(context.Background())
+	} else {
+		ctx, cancel = context.WithDeadline(context.Background(), callCtx.Deadline)
+	}
+	p.mu.Lock()
+	if p.inflight == nil {
+		p.inflight = make(map[uint64]context.CancelFunc)
+	}
+	p.inflight[callCtx.RequestId] = cancel
+	_, cancelled := p.cancelled[callCtx.RequestId]
+	delete(p.cancelled, callCtx.RequestId)
+	p.mu.Unlock()
+	if cancelled {
+		cancel()
+	}
+	return ctx, func() {
+		p.mu.Lock()
+		delete(p.inflight, callCtx.RequestId)
+		p.mu.Unlock()
+		cancel()
+	}
+}
+
+func (p *ProviderRPCServer) Init(data InitData, reply *ProviderConfig) error {
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	conn, err := p.broker.Dial(data.RunnerBrokerId)
 	if err != nil {
-		return err
+		return rpcError(err)
+	}
+	client := rpc.NewClient(conn)
+	result, err := p.Impl.Init(ctx, &RunnerProviderRPCClient{client: client})
+	if err != nil {
+		client.Close()
+		return rpcError(err)
+	}
+	p.mu.Lock()
+	previous := p.runnerClient
+	p.runnerClient = client
+	p.mu.Unlock()
+	if previous != nil {
+		previous.Close()
 	}
 	*reply = result
 	return nil
 }
 
-func (p *ProviderRPCServer) InitSchema(_ any, reply *ObjectSchema) error {
-	result, err := p.Impl.InitSchema()
+func (p *ProviderRPCServer) InitSchema(callCtx CallContext, reply *ObjectSchema) error {
+	ctx, done := p.context(callCtx)
+	defer done()
+	result, err := p.Impl.InitSchema(ctx)
 	if err != nil {
-		return err
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
-func (p *ProviderRPCServer) MapPayloadToTrigger(data []byte, reply *string) error {
-	result, err := p.Impl.MapPayloadToTriggerKey(data)
+func (p *ProviderRPCServer) MapPayloadToTriggerKey(data PayloadData, reply *string) error {
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.MapPayloadToTriggerKey(ctx, data.Payload)
 	if err != nil {
-		return err
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
-func (p *ProviderRPCServer) ActionNames(_ any, reply *[]string) error {
-	result, err := p.Impl.ActionNames()
+func (p *ProviderRPCServer) ActionNames(callCtx CallContext, reply *[]string) error {
+	ctx, done := p.context(callCtx)
+	defer done()
+	result, err := p.Impl.ActionNames(ctx)
 	if err != nil {
-		return err
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
-func (p *ProviderRPCServer) ActionConfigurationSchema(name string, reply *ObjectSchema) error {
-	result, err := p.Impl.ActionConfigurationSchema(name)
+func (p *ProviderRPCServer) ActionConfigurationSchema(data NameData, reply *ObjectSchema) error {
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.ActionConfigurationSchema(ctx, data.Name)
 	if err != nil {
-		return err
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
-func (p *ProviderRPCServer) ActionOutputType(name string, reply *Type) error {
-	result, err := p.Impl.ActionOutputType(name)
+func (p *ProviderRPCServer) ActionOutputType(data NameData, reply *Type) error {
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.ActionOutputType(ctx, data.Name)
 	if err != nil {
-		return err
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
-func (p *ProviderRPCServer) ActionEvaluate(payload ActionEvalData, reply *[]byte) error {
-	result, err := p.Impl.ActionEvaluate(payload.ContextId, payload.Name, payload.Input)
+func (p *ProviderRPCServer) ActionMetadata(data NameData, reply *Metadata) error {
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.ActionMetadata(ctx, data.Name)
 	if err != nil {
-		return err
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
-func (p *ProviderRPCServer) TriggerConfigurationSchema(_ any, reply *ObjectSchema) error {
-	result, err := p.Impl.TriggerConfigurationSchema()
+func (p *ProviderRPCServer) ActionEvaluate(payload ActionEvalData, reply *ActionOutputData) error {
+	ctx, done := p.context(payload.CallContext)
+	defer done()
+	result, err := p.Impl.ActionEvaluate(ctx, payload.ContextId, payload.Name, payload.Input)
 	if err != nil {
-		return err
+		return rpcError(err)
+	}
+	*reply = actionOutputToData(result)
+	return nil
+}
+
+func (p *ProviderRPCServer) TriggerKeyNames(callCtx CallContext, reply *[]string) error {
+	ctx, done := p.context(callCtx)
+	defer done()
+	result, err := p.Impl.TriggerKeyNames(ctx)
+	if err != nil {
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
-func (p *ProviderRPCServer) TriggerOutputType(name string, reply *Type) error {
-	result, err := p.Impl.TriggerOutputType(name)
+func (p *ProviderRPCServer) TriggerConfigurationSchema(callCtx CallContext, reply *ObjectSchema) error {
+	ctx, done := p.context(callCtx)
+	defer done()
+	result, err := p.Impl.TriggerConfigurationSchema(ctx)
 	if err != nil {
-		return err
+		return rpcError(err)
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServer) TriggerOutputType(data NameData, reply *Type) error {
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.TriggerOutputType(ctx, data.Name)
+	if err != nil {
+		return rpcError(err)
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServer) TriggerMetadata(data NameData, reply *Metadata) error {
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.TriggerMetadata(ctx, data.Name)
+	if err != nil {
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
 func (p *ProviderRPCServer) CreateSubscription(data SubscriptionData, reply *[]byte) error {
-	result, err := p.Impl.CreateSubscription(data.ContextId, data.InputData)
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.CreateSubscription(ctx, data.ContextId, data.InputData)
 	if err != nil {
-		return err
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
 func (p *ProviderRPCServer) ReadSubscription(data SubscriptionData, reply *[]byte) error {
-	result, err := p.Impl.ReadSubscription(data.ContextId, data.SubscriptionId)
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.ReadSubscription(ctx, data.ContextId, data.SubscriptionId)
 	if err != nil {
-		return err
+		return rpcError(err)
 	}
 	*reply = result
 	return nil
 }
 
 func (p *ProviderRPCServer) UpdateSubscription(data SubscriptionData, reply *[]byte) error {
-	result, err := p.Impl.UpdateSubscription(data.ContextId, data.SubscriptionId, data.InputData)
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	result, err := p.Impl.UpdateSubscription(ctx, data.ContextId, data.SubscriptionId, data.InputData)
+	if err != nil {
+		return rpcError(err)
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServer) DeleteSubscription(data SubscriptionData, _ *[]byte) error {
+	ctx, done := p.context(data.CallContext)
+	defer done()
+	err := p.Impl.DeleteSubscription(ctx, data.ContextId, data.SubscriptionId)
+	if err != nil {
+		return rpcError(err)
+	}
+	return nil
+}
+
+// RunnerProviderRPCClient is handed to the plugin's Provider.Init, and calls back into
+// the runner over the broker stream negotiated during Init.
+type RunnerProviderRPCClient struct {
+	client *rpc.Client
+}
+
+func (r *RunnerProviderRPCClient) UserConfig() (map[string][]byte, error) {
+	var result map[string][]byte
+	err := r.client.Call("Plugin.UserConfig", new(interface{}), &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *RunnerProviderRPCClient) GlobalConfig() (GlobalConfig, error) {
+	var result GlobalConfig
+	err := r.client.Call("Plugin.GlobalConfig", new(interface{}), &result)
+	if err != nil {
+		return GlobalConfig{}, err
+	}
+	return result, nil
+}
+
+// RunnerProviderRPCServer serves the runner's RunnerProvider to the plugin.
+type RunnerProviderRPCServer struct {
+	Impl RunnerProviderV3
+}
+
+func (r *RunnerProviderRPCServer) UserConfig(_ any, reply *map[string][]byte) error {
+	result, err := r.Impl.UserConfig()
 	if err != nil {
 		return err
 	}
@@ -279,17 +662,21 @@ func (p *ProviderRPCServer) UpdateSubscription(data SubscriptionData, reply *[]b
 	return nil
 }
 
-func (p *ProviderRPCServer) DeleteSubscription(data SubscriptionData) error {
-	err := p.Impl.DeleteSubscription(data.ContextId, data.SubscriptionId)
+func (r *RunnerProviderRPCServer) GlobalConfig(_ any, reply *GlobalConfig) error {
+	result, err := r.Impl.GlobalConfig()
 	if err != nil {
 		return err
 	}
+	*reply = result
 	return nil
 }
 
 func init() {
 	gob.Register(ActionEvalData{})
 	gob.Register(InitData{})
+	gob.Register(NameData{})
+	gob.Register(PayloadData{})
+	gob.Register(CallContext{})
 	gob.Register(ProviderConfig{})
 	gob.Register(GlobalConfig{})
 	gob.Register(SubscriptionData{})
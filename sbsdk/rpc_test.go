@@ -0,0 +1,281 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+)
+
+func dispenseRPC(t *testing.T, impl ProviderV3) ProviderV3 {
+	t.Helper()
+	return dispenseRPCPlugin(t, &ProviderPlugin{Impl: impl})
+}
+
+func dispenseRPCV2(t *testing.T, impl ProviderV3) ProviderV3 {
+	t.Helper()
+	return dispenseRPCPlugin(t, &ProviderPluginV2{Impl: impl})
+}
+
+func dispenseRPCPlugin(t *testing.T, p plugin.Plugin) ProviderV3 {
+	t.Helper()
+	client, _ := plugin.TestPluginRPCConn(t, map[string]plugin.Plugin{"provider": p}, nil)
+	t.Cleanup(func() { client.Close() })
+	raw, err := client.Dispense("provider")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw.(ProviderV3)
+}
+
+// transports dispenses a client of impl over each transport of protocol version 3.
+var transports = map[string]func(*testing.T, ProviderV3) ProviderV3{
+	"net/rpc": dispenseRPC,
+	"grpc":    dispenseGRPC,
+}
+
+func TestRunnerProviderIsServedAfterInit(t *testing.T) {
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			impl := &testProvider{}
+			provider := dispense(t, impl)
+			runner := &testRunnerProvider{
+				userConfig:   map[string][]byte{"default": []byte(`{"name":"a"}`)},
+				globalConfig: GlobalConfig{PublicIngestUri: "https://public"},
+			}
+			if _, err := provider.Init(context.Background(), AdaptRunnerProvider(runner)); err != nil {
+				t.Fatal(err)
+			}
+			runner.userConfig = map[string][]byte{"default": []byte(`{"name":"b"}`)}
+			got, err := impl.runners[0].UserConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, runner.userConfig) {
+				t.Fatalf("got user config %q after Init, want %q", got, runner.userConfig)
+			}
+			globalConfig, err := impl.runners[0].GlobalConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if globalConfig != runner.globalConfig {
+				t.Fatalf("got global config %+v, want %+v", globalConfig, runner.globalConfig)
+			}
+		})
+	}
+}
+
+func TestReInitClosesThePreviousRunnerProvider(t *testing.T) {
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			impl := &testProvider{}
+			provider := dispense(t, impl)
+			first := &testRunnerProvider{userConfig: map[string][]byte{"default": []byte("first")}}
+			second := &testRunnerProvider{userConfig: map[string][]byte{"default": []byte("second")}}
+			if _, err := provider.Init(context.Background(), AdaptRunnerProvider(first)); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := provider.Init(context.Background(), AdaptRunnerProvider(second)); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := impl.runners[0].UserConfig(); err == nil {
+				t.Errorf("got user config %q from the replaced RunnerProvider, want an error", got)
+			}
+			got, err := impl.runners[1].UserConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, second.userConfig) {
+				t.Errorf("got user config %q, want %q", got, second.userConfig)
+			}
+		})
+	}
+}
+
+func TestFailedInitKeepsThePreviousRunnerProvider(t *testing.T) {
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			impl := &testProvider{}
+			provider := dispense(t, impl)
+			first := &testRunnerProvider{userConfig: map[string][]byte{"default": []byte("first")}}
+			if _, err := provider.Init(context.Background(), AdaptRunnerProvider(first)); err != nil {
+				t.Fatal(err)
+			}
+			impl.err = errors.New("invalid config")
+			failed := &testRunnerProvider{userConfig: map[string][]byte{"default": []byte("failed")}}
+			if _, err := provider.Init(context.Background(), AdaptRunnerProvider(failed)); err == nil {
+				t.Fatal("got no error from the failing Init")
+			}
+			if got, err := impl.runners[1].UserConfig(); err == nil {
+				t.Errorf("got user config %q from the RunnerProvider of the failed Init, want an error", got)
+			}
+			got, err := impl.runners[0].UserConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, first.userConfig) {
+				t.Errorf("got user config %q, want %q", got, first.userConfig)
+			}
+		})
+	}
+}
+
+func TestCancellationReachesTheProvider(t *testing.T) {
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			started := make(chan struct{})
+			stopped := make(chan error, 1)
+			impl := &testProvider{evaluate: func(ctx context.Context) ([]byte, error) {
+				close(started)
+				<-ctx.Done()
+				stopped <- ctx.Err()
+				return nil, ctx.Err()
+			}}
+			provider := dispense(t, impl)
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				<-started
+				cancel()
+			}()
+			if _, err := provider.ActionEvaluate(ctx, "ctx", "create_user", nil); err == nil {
+				t.Fatal("got no error from the cancelled call")
+			}
+			select {
+			case err := <-stopped:
+				if !errors.Is(err, context.Canceled) {
+					t.Fatalf("provider's context ended with %v, want %v", err, context.Canceled)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("the provider's context was never cancelled")
+			}
+		})
+	}
+}
+
+func TestDeadlineReachesTheProvider(t *testing.T) {
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			deadlines := make(chan time.Time, 1)
+			impl := &testProvider{evaluate: func(ctx context.Context) ([]byte, error) {
+				deadline, _ := ctx.Deadline()
+				deadlines <- deadline
+				return nil, nil
+			}}
+			provider := dispense(t, impl)
+			want := time.Now().Add(time.Hour)
+			ctx, cancel := context.WithDeadline(context.Background(), want)
+			defer cancel()
+			if _, err := provider.ActionEvaluate(ctx, "ctx", "create_user", nil); err != nil {
+				t.Fatal(err)
+			}
+			got := <-deadlines
+			if diff := got.Sub(want); diff < -time.Second || diff > time.Second {
+				t.Fatalf("got deadline %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestCancelBeforeCallIsKept(t *testing.T) {
+	server := &ProviderRPCServer{}
+	if err := server.Cancel(7, nil); err != nil {
+		t.Fatal(err)
+	}
+	ctx, done := server.context(CallContext{RequestId: 7})
+	defer done()
+	if ctx.Err() == nil {
+		t.Fatal("call cancelled before it started isn't cancelled")
+	}
+	other, otherDone := server.context(CallContext{RequestId: 8})
+	defer otherDone()
+	if other.Err() != nil {
+		t.Fatalf("unrelated call is cancelled: %s", other.Err())
+	}
+	if _, ok := server.cancelled[7]; ok {
+		t.Fatal("tombstone is kept after its call started")
+	}
+}
+
+func TestCancelTombstonesExpire(t *testing.T) {
+	server := &ProviderRPCServer{cancelled: map[uint64]time.Time{
+		1: time.Now().Add(-2 * cancelTombstoneTTL),
+	}}
+	if err := server.Cancel(2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := server.cancelled[1]; ok {
+		t.Fatal("expired tombstone is still kept")
+	}
+	if _, ok := server.cancelled[2]; !ok {
+		t.Fatal("new tombstone isn't kept")
+	}
+}
+
+func TestProtocolVersion2(t *testing.T) {
+	impl := &testProvider{
+		schema: ObjectSchema{Attributes: map[string]Schema{
+			"name": &AttrSchema{Name: "name", Required: true, Type: String},
+		}},
+		names: []string{"create_user"},
+	}
+	provider := dispenseRPCV2(t, impl)
+	ctx := context.Background()
+	runner := &testRunnerProvider{
+		userConfig:   map[string][]byte{"default": []byte(`{"name":"a"}`)},
+		globalConfig: GlobalConfig{PublicIngestUri: "https://public"},
+	}
+	if _, err := provider.Init(ctx, AdaptRunnerProvider(runner)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(impl.userConfig, runner.userConfig) || impl.globalConfig != runner.globalConfig {
+		t.Errorf("provider got user config %q and global config %+v", impl.userConfig, impl.globalConfig)
+	}
+	schema, err := provider.InitSchema(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(schema, impl.schema) {
+		t.Errorf("got schema %#v, want %#v", schema, impl.schema)
+	}
+	names, err := provider.TriggerKeyNames(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, impl.names) {
+		t.Errorf("got trigger keys %q, want %q", names, impl.names)
+	}
+	key, err := provider.MapPayloadToTriggerKey(ctx, []byte("user_created"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "user_created" {
+		t.Errorf("got trigger key %q", key)
+	}
+	output, err := provider.ActionEvaluate(ctx, "ctx", "create_user", []byte("input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(output.Value) != "ctx/create_user" {
+		t.Errorf("got output %q", output.Value)
+	}
+	if err := provider.DeleteSubscription(ctx, "ctx", "sub"); err != nil {
+		t.Fatal(err)
+	}
+	impl.err = errors.New("vendor is down")
+	if _, err := provider.ReadSubscription(ctx, "ctx", "sub"); err == nil || err.Error() != "vendor is down" {
+		t.Fatalf("got error %v, want the provider's error", err)
+	}
+}
+
+func TestVersionedPluginsServeBothProtocols(t *testing.T) {
+	plugins := VersionedPlugins(nil)
+	if _, ok := plugins[2]["provider"].(plugin.GRPCPlugin); ok {
+		t.Error("version 2 must only be served over net/rpc")
+	}
+	if _, ok := plugins[3]["provider"].(plugin.GRPCPlugin); !ok {
+		t.Error("version 3 must be served over gRPC")
+	}
+}
@@ -0,0 +1,403 @@
+package sbsdk
+
+import (
+	"context"
+	"encoding/gob"
+	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/hcl/v2/hcldec"
+	"net/rpc"
+)
+
+func init() {
+	gob.RegisterName("*github.com/switchboard-org/plugin-sdk/sbsdk.ObjectSchema", &ObjectSchemaV2{})
+}
+
+// ProviderPluginV2 is the go-plugin glue for protocol version 2, the net/rpc protocol that
+// predates ProviderV3, so that runners which only speak version 2 keep working with providers
+// built on this SDK. Version 2 can't cancel calls or carry their deadlines, nor the constraints
+// of an ObjectSchema, and the runner's configuration is sent with Init rather than served over
+// the broker.
+type ProviderPluginV2 struct {
+	Impl ProviderV3
+}
+
+func (p *ProviderPluginV2) Server(*plugin.MuxBroker) (interface{}, error) {
+	return &ProviderRPCServerV2{Impl: p.Impl}, nil
+}
+
+// Client returns the version 2 client wrapped with AdaptProvider, so that runners use it like
+// the client of version 3.
+func (p *ProviderPluginV2) Client(_ *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
+	return AdaptProvider(&ProviderRPCClientV2{client: c}), nil
+}
+
+// InitDataV2 is sent by the runner on Init in protocol version 2. It is a snapshot of the
+// runner's RunnerProvider, which the provider is handed in its place.
+type InitDataV2 struct {
+	GlobalConfig GlobalConfig
+	UserConfig   map[string][]byte
+}
+
+// ObjectSchemaV2 is the form of ObjectSchema in protocol version 2, which was a map of the
+// attributes with no room for constraints. It is registered with gob under the name ObjectSchema
+// had then, so that it can be nested in the BlockSchemas sent to and from version 2 runners.
+type ObjectSchemaV2 map[string]Schema
+
+func (s *ObjectSchemaV2) Decode() hcldec.Spec {
+	schema := s.objectSchema()
+	return schema.Decode()
+}
+
+func (s *ObjectSchemaV2) objectSchema() ObjectSchema {
+	out := ObjectSchema{Attributes: make(map[string]Schema, len(*s))}
+	for k, v := range *s {
+		out.Attributes[k] = schemaFromV2(v)
+	}
+	return out
+}
+
+func objectSchemaToV2(schema ObjectSchema) ObjectSchemaV2 {
+	out := make(ObjectSchemaV2, len(schema.Attributes))
+	for k, v := range schema.Attributes {
+		out[k] = schemaToV2(v)
+	}
+	return out
+}
+
+// schemaToV2 replaces every ObjectSchema nested in schema with its ObjectSchemaV2, leaving out
+// the constraints. The schemas it is given aren't modified.
+func schemaToV2(schema Schema) Schema {
+	switch s := schema.(type) {
+	case *ObjectSchema:
+		out := objectSchemaToV2(*s)
+		return &out
+	case *BlockSchema:
+		out := *s
+		out.Nested = schemaToV2(s.Nested)
+		return &out
+	default:
+		return schema
+	}
+}
+
+// schemaFromV2 is the reverse of schemaToV2.
+func schemaFromV2(schema Schema) Schema {
+	switch s := schema.(type) {
+	case *ObjectSchemaV2:
+		out := s.objectSchema()
+		return &out
+	case *BlockSchema:
+		out := *s
+		out.Nested = schemaFromV2(s.Nested)
+		return &out
+	default:
+		return schema
+	}
+}
+
+// ProviderRPCClientV2 is the runner side of protocol version 2.
+type ProviderRPCClientV2 struct {
+	client *rpc.Client
+}
+
+func (p *ProviderRPCClientV2) Init(runnerProvider RunnerProvider) (ProviderConfig, error) {
+	var result ProviderConfig
+	payload := InitDataV2{
+		GlobalConfig: runnerProvider.GlobalConfig(),
+		UserConfig:   runnerProvider.UserConfig(),
+	}
+	err := p.client.Call("Plugin.Init", payload, &result)
+	if err != nil {
+		return ProviderConfig{}, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) InitSchema() (ObjectSchema, error) {
+	var result ObjectSchemaV2
+	err := p.client.Call("Plugin.InitSchema", new(interface{}), &result)
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	return result.objectSchema(), nil
+}
+
+func (p *ProviderRPCClientV2) MapPayloadToTriggerKey(data []byte) (string, error) {
+	var result string
+	err := p.client.Call("Plugin.MapPayloadToTriggerKey", data, &result)
+	if err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) ActionNames() ([]string, error) {
+	var result []string
+	err := p.client.Call("Plugin.ActionNames", new(interface{}), &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) ActionConfigurationSchema(name string) (ObjectSchema, error) {
+	var result ObjectSchemaV2
+	err := p.client.Call("Plugin.ActionConfigurationSchema", name, &result)
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	return result.objectSchema(), nil
+}
+
+func (p *ProviderRPCClientV2) ActionOutputType(name string) (Type, error) {
+	var result Type
+	err := p.client.Call("Plugin.ActionOutputType", name, &result)
+	if err != nil {
+		return Type{}, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) ActionEvaluate(contextId string, name string, input []byte) ([]byte, error) {
+	var result []byte
+	payload := ActionEvalData{
+		ContextId: contextId,
+		Name:      name,
+		Input:     input,
+	}
+	err := p.client.Call("Plugin.ActionEvaluate", payload, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) TriggerKeyNames() ([]string, error) {
+	var result []string
+	err := p.client.Call("Plugin.TriggerKeyNames", new(interface{}), &result)
+	if err != nil {
+		return []string{}, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) TriggerConfigurationSchema() (ObjectSchema, error) {
+	var result ObjectSchemaV2
+	err := p.client.Call("Plugin.TriggerConfigurationSchema", new(interface{}), &result)
+	if err != nil {
+		return ObjectSchema{}, err
+	}
+	return result.objectSchema(), nil
+}
+
+func (p *ProviderRPCClientV2) TriggerOutputType(name string) (Type, error) {
+	var result Type
+	err := p.client.Call("Plugin.TriggerOutputType", name, &result)
+	if err != nil {
+		return Type{}, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) CreateSubscription(contextId string, input []byte) ([]byte, error) {
+	var result []byte
+	payload := SubscriptionData{
+		ContextId: contextId,
+		InputData: input,
+	}
+	err := p.client.Call("Plugin.CreateSubscription", payload, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) ReadSubscription(contextId string, subscriptionId string) ([]byte, error) {
+	var result []byte
+	payload := SubscriptionData{
+		ContextId:      contextId,
+		SubscriptionId: subscriptionId,
+	}
+	err := p.client.Call("Plugin.ReadSubscription", payload, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) UpdateSubscription(contextId string, subscriptionId string, input []byte) ([]byte, error) {
+	var result []byte
+	payload := SubscriptionData{
+		ContextId:      contextId,
+		SubscriptionId: subscriptionId,
+		InputData:      input,
+	}
+	err := p.client.Call("Plugin.UpdateSubscription", payload, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *ProviderRPCClientV2) DeleteSubscription(contextId string, subscriptionId string) error {
+	var result []byte
+	payload := SubscriptionData{
+		ContextId:      contextId,
+		SubscriptionId: subscriptionId,
+	}
+	err := p.client.Call("Plugin.DeleteSubscription", payload, &result)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ProviderRPCServerV2 is the plugin side of protocol version 2. Every call runs with a
+// background context, since version 2 doesn't carry the runner's.
+type ProviderRPCServerV2 struct {
+	Impl ProviderV3
+}
+
+func (p *ProviderRPCServerV2) Init(data InitDataV2, reply *ProviderConfig) error {
+	runnerProvider := &staticRunnerProvider{
+		userConfig:   data.UserConfig,
+		globalConfig: data.GlobalConfig,
+	}
+	result, err := p.Impl.Init(context.Background(), runnerProvider)
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) InitSchema(_ any, reply *ObjectSchemaV2) error {
+	result, err := p.Impl.InitSchema(context.Background())
+	if err != nil {
+		return err
+	}
+	*reply = objectSchemaToV2(result)
+	return nil
+}
+
+func (p *ProviderRPCServerV2) MapPayloadToTriggerKey(data []byte, reply *string) error {
+	result, err := p.Impl.MapPayloadToTriggerKey(context.Background(), data)
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) ActionNames(_ any, reply *[]string) error {
+	result, err := p.Impl.ActionNames(context.Background())
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) ActionConfigurationSchema(name string, reply *ObjectSchemaV2) error {
+	result, err := p.Impl.ActionConfigurationSchema(context.Background(), name)
+	if err != nil {
+		return err
+	}
+	*reply = objectSchemaToV2(result)
+	return nil
+}
+
+func (p *ProviderRPCServerV2) ActionOutputType(name string, reply *Type) error {
+	result, err := p.Impl.ActionOutputType(context.Background(), name)
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) ActionEvaluate(payload ActionEvalData, reply *[]byte) error {
+	result, err := p.Impl.ActionEvaluate(context.Background(), payload.ContextId, payload.Name, payload.Input)
+	if err != nil {
+		return err
+	}
+	*reply = result.Value
+	return nil
+}
+
+func (p *ProviderRPCServerV2) TriggerKeyNames(_ any, reply *[]string) error {
+	result, err := p.Impl.TriggerKeyNames(context.Background())
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) TriggerConfigurationSchema(_ any, reply *ObjectSchemaV2) error {
+	result, err := p.Impl.TriggerConfigurationSchema(context.Background())
+	if err != nil {
+		return err
+	}
+	*reply = objectSchemaToV2(result)
+	return nil
+}
+
+func (p *ProviderRPCServerV2) TriggerOutputType(name string, reply *Type) error {
+	result, err := p.Impl.TriggerOutputType(context.Background(), name)
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) CreateSubscription(data SubscriptionData, reply *[]byte) error {
+	result, err := p.Impl.CreateSubscription(context.Background(), data.ContextId, data.InputData)
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) ReadSubscription(data SubscriptionData, reply *[]byte) error {
+	result, err := p.Impl.ReadSubscription(context.Background(), data.ContextId, data.SubscriptionId)
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) UpdateSubscription(data SubscriptionData, reply *[]byte) error {
+	result, err := p.Impl.UpdateSubscription(context.Background(), data.ContextId, data.SubscriptionId, data.InputData)
+	if err != nil {
+		return err
+	}
+	*reply = result
+	return nil
+}
+
+func (p *ProviderRPCServerV2) DeleteSubscription(data SubscriptionData, _ *[]byte) error {
+	err := p.Impl.DeleteSubscription(context.Background(), data.ContextId, data.SubscriptionId)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// staticRunnerProvider serves the snapshot of the runner's configuration sent with Init in
+// protocol version 2.
+type staticRunnerProvider struct {
+	userConfig   map[string][]byte
+	globalConfig GlobalConfig
+}
+
+func (s *staticRunnerProvider) UserConfig() (map[string][]byte, error) {
+	return s.userConfig, nil
+}
+
+func (s *staticRunnerProvider) GlobalConfig() (GlobalConfig, error) {
+	return s.globalConfig, nil
+}
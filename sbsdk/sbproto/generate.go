@@ -0,0 +1,5 @@
+// Package sbproto contains the generated gRPC bindings for the Switchboard provider
+// protocol. Edit provider.proto and regenerate; never edit provider.pb.go by hand.
+package sbproto
+
+//go:generate protoc --go_out=plugins=grpc,paths=source_relative:. provider.proto
@@ -0,0 +1,3532 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: provider.proto
+
+package sbproto
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ErrorKind int32
+
+const (
+	ErrorKind_UNKNOWN      ErrorKind = 0
+	ErrorKind_RETRYABLE    ErrorKind = 1
+	ErrorKind_RATE_LIMITED ErrorKind = 2
+	ErrorKind_PERMANENT    ErrorKind = 3
+	ErrorKind_UNAUTHORIZED ErrorKind = 4
+	ErrorKind_NOT_FOUND    ErrorKind = 5
+	ErrorKind_CONFLICT     ErrorKind = 6
+)
+
+var ErrorKind_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "RETRYABLE",
+	2: "RATE_LIMITED",
+	3: "PERMANENT",
+	4: "UNAUTHORIZED",
+	5: "NOT_FOUND",
+	6: "CONFLICT",
+}
+
+var ErrorKind_value = map[string]int32{
+	"UNKNOWN":      0,
+	"RETRYABLE":    1,
+	"RATE_LIMITED": 2,
+	"PERMANENT":    3,
+	"UNAUTHORIZED": 4,
+	"NOT_FOUND":    5,
+	"CONFLICT":     6,
+}
+
+func (x ErrorKind) String() string {
+	return proto.EnumName(ErrorKind_name, int32(x))
+}
+
+func (ErrorKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{0}
+}
+
+type Diagnostic_Severity int32
+
+const (
+	Diagnostic_INVALID Diagnostic_Severity = 0
+	Diagnostic_ERROR   Diagnostic_Severity = 1
+	Diagnostic_WARNING Diagnostic_Severity = 2
+)
+
+var Diagnostic_Severity_name = map[int32]string{
+	0: "INVALID",
+	1: "ERROR",
+	2: "WARNING",
+}
+
+var Diagnostic_Severity_value = map[string]int32{
+	"INVALID": 0,
+	"ERROR":   1,
+	"WARNING": 2,
+}
+
+func (x Diagnostic_Severity) String() string {
+	return proto.EnumName(Diagnostic_Severity_name, int32(x))
+}
+
+func (Diagnostic_Severity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{0, 0}
+}
+
+// Diagnostic is the wire form of sbsdk.Diagnostic. Failed calls return their diagnostics in the
+// response rather than as a gRPC status, so that they arrive intact.
+type Diagnostic struct {
+	Severity  Diagnostic_Severity `protobuf:"varint,1,opt,name=severity,proto3,enum=switchboard.provider.v3.Diagnostic_Severity" json:"severity,omitempty"`
+	Summary   string              `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Detail    string              `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Attribute *AttributePath      `protobuf:"bytes,4,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Subject   *Range              `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	// code identifies problems the runner may need to recognise, such as "unknown_action",
+	// without relying on their wording. See the DIAG_CODE constants of sbsdk.
+	Code string `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+	// kind and retry_after_millis classify an error so the runner can decide whether to retry.
+	Kind                 ErrorKind `protobuf:"varint,7,opt,name=kind,proto3,enum=switchboard.provider.v3.ErrorKind" json:"kind,omitempty"`
+	RetryAfterMillis     int64     `protobuf:"varint,8,opt,name=retry_after_millis,json=retryAfterMillis,proto3" json:"retry_after_millis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Diagnostic) Reset()         { *m = Diagnostic{} }
+func (m *Diagnostic) String() string { return proto.CompactTextString(m) }
+func (*Diagnostic) ProtoMessage()    {}
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{0}
+}
+
+func (m *Diagnostic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Diagnostic.Unmarshal(m, b)
+}
+func (m *Diagnostic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Diagnostic.Marshal(b, m, deterministic)
+}
+func (m *Diagnostic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Diagnostic.Merge(m, src)
+}
+func (m *Diagnostic) XXX_Size() int {
+	return xxx_messageInfo_Diagnostic.Size(m)
+}
+func (m *Diagnostic) XXX_DiscardUnknown() {
+	xxx_messageInfo_Diagnostic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Diagnostic proto.InternalMessageInfo
+
+func (m *Diagnostic) GetSeverity() Diagnostic_Severity {
+	if m != nil {
+		return m.Severity
+	}
+	return Diagnostic_INVALID
+}
+
+func (m *Diagnostic) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *Diagnostic) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *Diagnostic) GetAttribute() *AttributePath {
+	if m != nil {
+		return m.Attribute
+	}
+	return nil
+}
+
+func (m *Diagnostic) GetSubject() *Range {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (m *Diagnostic) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Diagnostic) GetKind() ErrorKind {
+	if m != nil {
+		return m.Kind
+	}
+	return ErrorKind_UNKNOWN
+}
+
+func (m *Diagnostic) GetRetryAfterMillis() int64 {
+	if m != nil {
+		return m.RetryAfterMillis
+	}
+	return 0
+}
+
+type AttributePath struct {
+	Steps                []*AttributePath_Step `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AttributePath) Reset()         { *m = AttributePath{} }
+func (m *AttributePath) String() string { return proto.CompactTextString(m) }
+func (*AttributePath) ProtoMessage()    {}
+func (*AttributePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{1}
+}
+
+func (m *AttributePath) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttributePath.Unmarshal(m, b)
+}
+func (m *AttributePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttributePath.Marshal(b, m, deterministic)
+}
+func (m *AttributePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributePath.Merge(m, src)
+}
+func (m *AttributePath) XXX_Size() int {
+	return xxx_messageInfo_AttributePath.Size(m)
+}
+func (m *AttributePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributePath proto.InternalMessageInfo
+
+func (m *AttributePath) GetSteps() []*AttributePath_Step {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+type AttributePath_Step struct {
+	// Types that are valid to be assigned to Selector:
+	//	*AttributePath_Step_AttributeName
+	//	*AttributePath_Step_ElementKeyString
+	//	*AttributePath_Step_ElementKeyInt
+	Selector             isAttributePath_Step_Selector `protobuf_oneof:"selector"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *AttributePath_Step) Reset()         { *m = AttributePath_Step{} }
+func (m *AttributePath_Step) String() string { return proto.CompactTextString(m) }
+func (*AttributePath_Step) ProtoMessage()    {}
+func (*AttributePath_Step) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{1, 0}
+}
+
+func (m *AttributePath_Step) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttributePath_Step.Unmarshal(m, b)
+}
+func (m *AttributePath_Step) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttributePath_Step.Marshal(b, m, deterministic)
+}
+func (m *AttributePath_Step) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributePath_Step.Merge(m, src)
+}
+func (m *AttributePath_Step) XXX_Size() int {
+	return xxx_messageInfo_AttributePath_Step.Size(m)
+}
+func (m *AttributePath_Step) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributePath_Step.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributePath_Step proto.InternalMessageInfo
+
+type isAttributePath_Step_Selector interface {
+	isAttributePath_Step_Selector()
+}
+
+type AttributePath_Step_AttributeName struct {
+	AttributeName string `protobuf:"bytes,1,opt,name=attribute_name,json=attributeName,proto3,oneof"`
+}
+
+type AttributePath_Step_ElementKeyString struct {
+	ElementKeyString string `protobuf:"bytes,2,opt,name=element_key_string,json=elementKeyString,proto3,oneof"`
+}
+
+type AttributePath_Step_ElementKeyInt struct {
+	ElementKeyInt int64 `protobuf:"varint,3,opt,name=element_key_int,json=elementKeyInt,proto3,oneof"`
+}
+
+func (*AttributePath_Step_AttributeName) isAttributePath_Step_Selector() {}
+
+func (*AttributePath_Step_ElementKeyString) isAttributePath_Step_Selector() {}
+
+func (*AttributePath_Step_ElementKeyInt) isAttributePath_Step_Selector() {}
+
+func (m *AttributePath_Step) GetSelector() isAttributePath_Step_Selector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+func (m *AttributePath_Step) GetAttributeName() string {
+	if x, ok := m.GetSelector().(*AttributePath_Step_AttributeName); ok {
+		return x.AttributeName
+	}
+	return ""
+}
+
+func (m *AttributePath_Step) GetElementKeyString() string {
+	if x, ok := m.GetSelector().(*AttributePath_Step_ElementKeyString); ok {
+		return x.ElementKeyString
+	}
+	return ""
+}
+
+func (m *AttributePath_Step) GetElementKeyInt() int64 {
+	if x, ok := m.GetSelector().(*AttributePath_Step_ElementKeyInt); ok {
+		return x.ElementKeyInt
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AttributePath_Step) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AttributePath_Step_AttributeName)(nil),
+		(*AttributePath_Step_ElementKeyString)(nil),
+		(*AttributePath_Step_ElementKeyInt)(nil),
+	}
+}
+
+type Range struct {
+	Filename             string     `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Start                *Range_Pos `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *Range_Pos `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Range) Reset()         { *m = Range{} }
+func (m *Range) String() string { return proto.CompactTextString(m) }
+func (*Range) ProtoMessage()    {}
+func (*Range) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{2}
+}
+
+func (m *Range) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Range.Unmarshal(m, b)
+}
+func (m *Range) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Range.Marshal(b, m, deterministic)
+}
+func (m *Range) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Range.Merge(m, src)
+}
+func (m *Range) XXX_Size() int {
+	return xxx_messageInfo_Range.Size(m)
+}
+func (m *Range) XXX_DiscardUnknown() {
+	xxx_messageInfo_Range.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Range proto.InternalMessageInfo
+
+func (m *Range) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *Range) GetStart() *Range_Pos {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *Range) GetEnd() *Range_Pos {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+type Range_Pos struct {
+	Line                 int64    `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Column               int64    `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Byte                 int64    `protobuf:"varint,3,opt,name=byte,proto3" json:"byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Range_Pos) Reset()         { *m = Range_Pos{} }
+func (m *Range_Pos) String() string { return proto.CompactTextString(m) }
+func (*Range_Pos) ProtoMessage()    {}
+func (*Range_Pos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{2, 0}
+}
+
+func (m *Range_Pos) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Range_Pos.Unmarshal(m, b)
+}
+func (m *Range_Pos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Range_Pos.Marshal(b, m, deterministic)
+}
+func (m *Range_Pos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Range_Pos.Merge(m, src)
+}
+func (m *Range_Pos) XXX_Size() int {
+	return xxx_messageInfo_Range_Pos.Size(m)
+}
+func (m *Range_Pos) XXX_DiscardUnknown() {
+	xxx_messageInfo_Range_Pos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Range_Pos proto.InternalMessageInfo
+
+func (m *Range_Pos) GetLine() int64 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *Range_Pos) GetColumn() int64 {
+	if m != nil {
+		return m.Column
+	}
+	return 0
+}
+
+func (m *Range_Pos) GetByte() int64 {
+	if m != nil {
+		return m.Byte
+	}
+	return 0
+}
+
+// Metadata is the wire form of sbsdk.Metadata, describing an action or trigger for humans.
+type Metadata struct {
+	Description          string   `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	MarkdownDescription  string   `protobuf:"bytes,2,opt,name=markdown_description,json=markdownDescription,proto3" json:"markdown_description,omitempty"`
+	Examples             []string `protobuf:"bytes,3,rep,name=examples,proto3" json:"examples,omitempty"`
+	Deprecated           bool     `protobuf:"varint,4,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	DeprecationMessage   string   `protobuf:"bytes,5,opt,name=deprecation_message,json=deprecationMessage,proto3" json:"deprecation_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{3}
+}
+
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Metadata.Unmarshal(m, b)
+}
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+}
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
+}
+func (m *Metadata) XXX_Size() int {
+	return xxx_messageInfo_Metadata.Size(m)
+}
+func (m *Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metadata proto.InternalMessageInfo
+
+func (m *Metadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Metadata) GetMarkdownDescription() string {
+	if m != nil {
+		return m.MarkdownDescription
+	}
+	return ""
+}
+
+func (m *Metadata) GetExamples() []string {
+	if m != nil {
+		return m.Examples
+	}
+	return nil
+}
+
+func (m *Metadata) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *Metadata) GetDeprecationMessage() string {
+	if m != nil {
+		return m.DeprecationMessage
+	}
+	return ""
+}
+
+type UserConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserConfig) Reset()         { *m = UserConfig{} }
+func (m *UserConfig) String() string { return proto.CompactTextString(m) }
+func (*UserConfig) ProtoMessage()    {}
+func (*UserConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{4}
+}
+
+func (m *UserConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserConfig.Unmarshal(m, b)
+}
+func (m *UserConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserConfig.Marshal(b, m, deterministic)
+}
+func (m *UserConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserConfig.Merge(m, src)
+}
+func (m *UserConfig) XXX_Size() int {
+	return xxx_messageInfo_UserConfig.Size(m)
+}
+func (m *UserConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserConfig proto.InternalMessageInfo
+
+type UserConfig_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserConfig_Request) Reset()         { *m = UserConfig_Request{} }
+func (m *UserConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*UserConfig_Request) ProtoMessage()    {}
+func (*UserConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{4, 0}
+}
+
+func (m *UserConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserConfig_Request.Unmarshal(m, b)
+}
+func (m *UserConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserConfig_Request.Marshal(b, m, deterministic)
+}
+func (m *UserConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserConfig_Request.Merge(m, src)
+}
+func (m *UserConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_UserConfig_Request.Size(m)
+}
+func (m *UserConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserConfig_Request proto.InternalMessageInfo
+
+type UserConfig_Response struct {
+	// config is keyed by context ID, and each value is cty JSON conforming
+	// to the schema returned by InitSchema.
+	Config               map[string][]byte `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UserConfig_Response) Reset()         { *m = UserConfig_Response{} }
+func (m *UserConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*UserConfig_Response) ProtoMessage()    {}
+func (*UserConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{4, 1}
+}
+
+func (m *UserConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserConfig_Response.Unmarshal(m, b)
+}
+func (m *UserConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserConfig_Response.Marshal(b, m, deterministic)
+}
+func (m *UserConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserConfig_Response.Merge(m, src)
+}
+func (m *UserConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_UserConfig_Response.Size(m)
+}
+func (m *UserConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserConfig_Response proto.InternalMessageInfo
+
+func (m *UserConfig_Response) GetConfig() map[string][]byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type GlobalConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GlobalConfig) Reset()         { *m = GlobalConfig{} }
+func (m *GlobalConfig) String() string { return proto.CompactTextString(m) }
+func (*GlobalConfig) ProtoMessage()    {}
+func (*GlobalConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{5}
+}
+
+func (m *GlobalConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GlobalConfig.Unmarshal(m, b)
+}
+func (m *GlobalConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GlobalConfig.Marshal(b, m, deterministic)
+}
+func (m *GlobalConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalConfig.Merge(m, src)
+}
+func (m *GlobalConfig) XXX_Size() int {
+	return xxx_messageInfo_GlobalConfig.Size(m)
+}
+func (m *GlobalConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalConfig proto.InternalMessageInfo
+
+type GlobalConfig_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GlobalConfig_Request) Reset()         { *m = GlobalConfig_Request{} }
+func (m *GlobalConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*GlobalConfig_Request) ProtoMessage()    {}
+func (*GlobalConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{5, 0}
+}
+
+func (m *GlobalConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GlobalConfig_Request.Unmarshal(m, b)
+}
+func (m *GlobalConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GlobalConfig_Request.Marshal(b, m, deterministic)
+}
+func (m *GlobalConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalConfig_Request.Merge(m, src)
+}
+func (m *GlobalConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_GlobalConfig_Request.Size(m)
+}
+func (m *GlobalConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalConfig_Request proto.InternalMessageInfo
+
+type GlobalConfig_Response struct {
+	PublicIngestUri      string   `protobuf:"bytes,1,opt,name=public_ingest_uri,json=publicIngestUri,proto3" json:"public_ingest_uri,omitempty"`
+	PrivateIngestUri     string   `protobuf:"bytes,2,opt,name=private_ingest_uri,json=privateIngestUri,proto3" json:"private_ingest_uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GlobalConfig_Response) Reset()         { *m = GlobalConfig_Response{} }
+func (m *GlobalConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*GlobalConfig_Response) ProtoMessage()    {}
+func (*GlobalConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{5, 1}
+}
+
+func (m *GlobalConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GlobalConfig_Response.Unmarshal(m, b)
+}
+func (m *GlobalConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GlobalConfig_Response.Marshal(b, m, deterministic)
+}
+func (m *GlobalConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalConfig_Response.Merge(m, src)
+}
+func (m *GlobalConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_GlobalConfig_Response.Size(m)
+}
+func (m *GlobalConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalConfig_Response proto.InternalMessageInfo
+
+func (m *GlobalConfig_Response) GetPublicIngestUri() string {
+	if m != nil {
+		return m.PublicIngestUri
+	}
+	return ""
+}
+
+func (m *GlobalConfig_Response) GetPrivateIngestUri() string {
+	if m != nil {
+		return m.PrivateIngestUri
+	}
+	return ""
+}
+
+type Init struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Init) Reset()         { *m = Init{} }
+func (m *Init) String() string { return proto.CompactTextString(m) }
+func (*Init) ProtoMessage()    {}
+func (*Init) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{6}
+}
+
+func (m *Init) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Init.Unmarshal(m, b)
+}
+func (m *Init) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Init.Marshal(b, m, deterministic)
+}
+func (m *Init) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Init.Merge(m, src)
+}
+func (m *Init) XXX_Size() int {
+	return xxx_messageInfo_Init.Size(m)
+}
+func (m *Init) XXX_DiscardUnknown() {
+	xxx_messageInfo_Init.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Init proto.InternalMessageInfo
+
+type Init_Request struct {
+	// runner_broker_id is the go-plugin broker stream on which the runner serves
+	// the Runner service for this provider.
+	RunnerBrokerId       uint32   `protobuf:"varint,1,opt,name=runner_broker_id,json=runnerBrokerId,proto3" json:"runner_broker_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Init_Request) Reset()         { *m = Init_Request{} }
+func (m *Init_Request) String() string { return proto.CompactTextString(m) }
+func (*Init_Request) ProtoMessage()    {}
+func (*Init_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{6, 0}
+}
+
+func (m *Init_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Init_Request.Unmarshal(m, b)
+}
+func (m *Init_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Init_Request.Marshal(b, m, deterministic)
+}
+func (m *Init_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Init_Request.Merge(m, src)
+}
+func (m *Init_Request) XXX_Size() int {
+	return xxx_messageInfo_Init_Request.Size(m)
+}
+func (m *Init_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Init_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Init_Request proto.InternalMessageInfo
+
+func (m *Init_Request) GetRunnerBrokerId() uint32 {
+	if m != nil {
+		return m.RunnerBrokerId
+	}
+	return 0
+}
+
+type Init_Response struct {
+	SubscriptionsRegisteredTogether bool          `protobuf:"varint,1,opt,name=subscriptions_registered_together,json=subscriptionsRegisteredTogether,proto3" json:"subscriptions_registered_together,omitempty"`
+	Diagnostics                     []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral            struct{}      `json:"-"`
+	XXX_unrecognized                []byte        `json:"-"`
+	XXX_sizecache                   int32         `json:"-"`
+}
+
+func (m *Init_Response) Reset()         { *m = Init_Response{} }
+func (m *Init_Response) String() string { return proto.CompactTextString(m) }
+func (*Init_Response) ProtoMessage()    {}
+func (*Init_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{6, 1}
+}
+
+func (m *Init_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Init_Response.Unmarshal(m, b)
+}
+func (m *Init_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Init_Response.Marshal(b, m, deterministic)
+}
+func (m *Init_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Init_Response.Merge(m, src)
+}
+func (m *Init_Response) XXX_Size() int {
+	return xxx_messageInfo_Init_Response.Size(m)
+}
+func (m *Init_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_Init_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Init_Response proto.InternalMessageInfo
+
+func (m *Init_Response) GetSubscriptionsRegisteredTogether() bool {
+	if m != nil {
+		return m.SubscriptionsRegisteredTogether
+	}
+	return false
+}
+
+func (m *Init_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type InitSchema struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitSchema) Reset()         { *m = InitSchema{} }
+func (m *InitSchema) String() string { return proto.CompactTextString(m) }
+func (*InitSchema) ProtoMessage()    {}
+func (*InitSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{7}
+}
+
+func (m *InitSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitSchema.Unmarshal(m, b)
+}
+func (m *InitSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitSchema.Marshal(b, m, deterministic)
+}
+func (m *InitSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitSchema.Merge(m, src)
+}
+func (m *InitSchema) XXX_Size() int {
+	return xxx_messageInfo_InitSchema.Size(m)
+}
+func (m *InitSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitSchema proto.InternalMessageInfo
+
+type InitSchema_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitSchema_Request) Reset()         { *m = InitSchema_Request{} }
+func (m *InitSchema_Request) String() string { return proto.CompactTextString(m) }
+func (*InitSchema_Request) ProtoMessage()    {}
+func (*InitSchema_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{7, 0}
+}
+
+func (m *InitSchema_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitSchema_Request.Unmarshal(m, b)
+}
+func (m *InitSchema_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitSchema_Request.Marshal(b, m, deterministic)
+}
+func (m *InitSchema_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitSchema_Request.Merge(m, src)
+}
+func (m *InitSchema_Request) XXX_Size() int {
+	return xxx_messageInfo_InitSchema_Request.Size(m)
+}
+func (m *InitSchema_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitSchema_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitSchema_Request proto.InternalMessageInfo
+
+type InitSchema_Response struct {
+	Schema               []byte        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *InitSchema_Response) Reset()         { *m = InitSchema_Response{} }
+func (m *InitSchema_Response) String() string { return proto.CompactTextString(m) }
+func (*InitSchema_Response) ProtoMessage()    {}
+func (*InitSchema_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{7, 1}
+}
+
+func (m *InitSchema_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitSchema_Response.Unmarshal(m, b)
+}
+func (m *InitSchema_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitSchema_Response.Marshal(b, m, deterministic)
+}
+func (m *InitSchema_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitSchema_Response.Merge(m, src)
+}
+func (m *InitSchema_Response) XXX_Size() int {
+	return xxx_messageInfo_InitSchema_Response.Size(m)
+}
+func (m *InitSchema_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitSchema_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitSchema_Response proto.InternalMessageInfo
+
+func (m *InitSchema_Response) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *InitSchema_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ActionNames struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionNames) Reset()         { *m = ActionNames{} }
+func (m *ActionNames) String() string { return proto.CompactTextString(m) }
+func (*ActionNames) ProtoMessage()    {}
+func (*ActionNames) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{8}
+}
+
+func (m *ActionNames) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionNames.Unmarshal(m, b)
+}
+func (m *ActionNames) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionNames.Marshal(b, m, deterministic)
+}
+func (m *ActionNames) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionNames.Merge(m, src)
+}
+func (m *ActionNames) XXX_Size() int {
+	return xxx_messageInfo_ActionNames.Size(m)
+}
+func (m *ActionNames) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionNames.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionNames proto.InternalMessageInfo
+
+type ActionNames_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionNames_Request) Reset()         { *m = ActionNames_Request{} }
+func (m *ActionNames_Request) String() string { return proto.CompactTextString(m) }
+func (*ActionNames_Request) ProtoMessage()    {}
+func (*ActionNames_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{8, 0}
+}
+
+func (m *ActionNames_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionNames_Request.Unmarshal(m, b)
+}
+func (m *ActionNames_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionNames_Request.Marshal(b, m, deterministic)
+}
+func (m *ActionNames_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionNames_Request.Merge(m, src)
+}
+func (m *ActionNames_Request) XXX_Size() int {
+	return xxx_messageInfo_ActionNames_Request.Size(m)
+}
+func (m *ActionNames_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionNames_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionNames_Request proto.InternalMessageInfo
+
+type ActionNames_Response struct {
+	Names                []string      `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ActionNames_Response) Reset()         { *m = ActionNames_Response{} }
+func (m *ActionNames_Response) String() string { return proto.CompactTextString(m) }
+func (*ActionNames_Response) ProtoMessage()    {}
+func (*ActionNames_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{8, 1}
+}
+
+func (m *ActionNames_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionNames_Response.Unmarshal(m, b)
+}
+func (m *ActionNames_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionNames_Response.Marshal(b, m, deterministic)
+}
+func (m *ActionNames_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionNames_Response.Merge(m, src)
+}
+func (m *ActionNames_Response) XXX_Size() int {
+	return xxx_messageInfo_ActionNames_Response.Size(m)
+}
+func (m *ActionNames_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionNames_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionNames_Response proto.InternalMessageInfo
+
+func (m *ActionNames_Response) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *ActionNames_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ActionEvaluate struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionEvaluate) Reset()         { *m = ActionEvaluate{} }
+func (m *ActionEvaluate) String() string { return proto.CompactTextString(m) }
+func (*ActionEvaluate) ProtoMessage()    {}
+func (*ActionEvaluate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{9}
+}
+
+func (m *ActionEvaluate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionEvaluate.Unmarshal(m, b)
+}
+func (m *ActionEvaluate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionEvaluate.Marshal(b, m, deterministic)
+}
+func (m *ActionEvaluate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionEvaluate.Merge(m, src)
+}
+func (m *ActionEvaluate) XXX_Size() int {
+	return xxx_messageInfo_ActionEvaluate.Size(m)
+}
+func (m *ActionEvaluate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionEvaluate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionEvaluate proto.InternalMessageInfo
+
+type ActionEvaluate_Request struct {
+	ContextId            string   `protobuf:"bytes,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Input                []byte   `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionEvaluate_Request) Reset()         { *m = ActionEvaluate_Request{} }
+func (m *ActionEvaluate_Request) String() string { return proto.CompactTextString(m) }
+func (*ActionEvaluate_Request) ProtoMessage()    {}
+func (*ActionEvaluate_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{9, 0}
+}
+
+func (m *ActionEvaluate_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionEvaluate_Request.Unmarshal(m, b)
+}
+func (m *ActionEvaluate_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionEvaluate_Request.Marshal(b, m, deterministic)
+}
+func (m *ActionEvaluate_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionEvaluate_Request.Merge(m, src)
+}
+func (m *ActionEvaluate_Request) XXX_Size() int {
+	return xxx_messageInfo_ActionEvaluate_Request.Size(m)
+}
+func (m *ActionEvaluate_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionEvaluate_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionEvaluate_Request proto.InternalMessageInfo
+
+func (m *ActionEvaluate_Request) GetContextId() string {
+	if m != nil {
+		return m.ContextId
+	}
+	return ""
+}
+
+func (m *ActionEvaluate_Request) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ActionEvaluate_Request) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+type ActionEvaluate_Response struct {
+	Output      []byte        `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// sensitive_paths are the paths of the values in output that are sensitive. The output is
+	// cty JSON, which can't mark them itself.
+	SensitivePaths       []*AttributePath `protobuf:"bytes,3,rep,name=sensitive_paths,json=sensitivePaths,proto3" json:"sensitive_paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ActionEvaluate_Response) Reset()         { *m = ActionEvaluate_Response{} }
+func (m *ActionEvaluate_Response) String() string { return proto.CompactTextString(m) }
+func (*ActionEvaluate_Response) ProtoMessage()    {}
+func (*ActionEvaluate_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{9, 1}
+}
+
+func (m *ActionEvaluate_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionEvaluate_Response.Unmarshal(m, b)
+}
+func (m *ActionEvaluate_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionEvaluate_Response.Marshal(b, m, deterministic)
+}
+func (m *ActionEvaluate_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionEvaluate_Response.Merge(m, src)
+}
+func (m *ActionEvaluate_Response) XXX_Size() int {
+	return xxx_messageInfo_ActionEvaluate_Response.Size(m)
+}
+func (m *ActionEvaluate_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionEvaluate_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionEvaluate_Response proto.InternalMessageInfo
+
+func (m *ActionEvaluate_Response) GetOutput() []byte {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *ActionEvaluate_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *ActionEvaluate_Response) GetSensitivePaths() []*AttributePath {
+	if m != nil {
+		return m.SensitivePaths
+	}
+	return nil
+}
+
+type ActionConfigurationSchema struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionConfigurationSchema) Reset()         { *m = ActionConfigurationSchema{} }
+func (m *ActionConfigurationSchema) String() string { return proto.CompactTextString(m) }
+func (*ActionConfigurationSchema) ProtoMessage()    {}
+func (*ActionConfigurationSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{10}
+}
+
+func (m *ActionConfigurationSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionConfigurationSchema.Unmarshal(m, b)
+}
+func (m *ActionConfigurationSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionConfigurationSchema.Marshal(b, m, deterministic)
+}
+func (m *ActionConfigurationSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionConfigurationSchema.Merge(m, src)
+}
+func (m *ActionConfigurationSchema) XXX_Size() int {
+	return xxx_messageInfo_ActionConfigurationSchema.Size(m)
+}
+func (m *ActionConfigurationSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionConfigurationSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionConfigurationSchema proto.InternalMessageInfo
+
+type ActionConfigurationSchema_Request struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionConfigurationSchema_Request) Reset()         { *m = ActionConfigurationSchema_Request{} }
+func (m *ActionConfigurationSchema_Request) String() string { return proto.CompactTextString(m) }
+func (*ActionConfigurationSchema_Request) ProtoMessage()    {}
+func (*ActionConfigurationSchema_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{10, 0}
+}
+
+func (m *ActionConfigurationSchema_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionConfigurationSchema_Request.Unmarshal(m, b)
+}
+func (m *ActionConfigurationSchema_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionConfigurationSchema_Request.Marshal(b, m, deterministic)
+}
+func (m *ActionConfigurationSchema_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionConfigurationSchema_Request.Merge(m, src)
+}
+func (m *ActionConfigurationSchema_Request) XXX_Size() int {
+	return xxx_messageInfo_ActionConfigurationSchema_Request.Size(m)
+}
+func (m *ActionConfigurationSchema_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionConfigurationSchema_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionConfigurationSchema_Request proto.InternalMessageInfo
+
+func (m *ActionConfigurationSchema_Request) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ActionConfigurationSchema_Response struct {
+	Schema               []byte        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ActionConfigurationSchema_Response) Reset()         { *m = ActionConfigurationSchema_Response{} }
+func (m *ActionConfigurationSchema_Response) String() string { return proto.CompactTextString(m) }
+func (*ActionConfigurationSchema_Response) ProtoMessage()    {}
+func (*ActionConfigurationSchema_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{10, 1}
+}
+
+func (m *ActionConfigurationSchema_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionConfigurationSchema_Response.Unmarshal(m, b)
+}
+func (m *ActionConfigurationSchema_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionConfigurationSchema_Response.Marshal(b, m, deterministic)
+}
+func (m *ActionConfigurationSchema_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionConfigurationSchema_Response.Merge(m, src)
+}
+func (m *ActionConfigurationSchema_Response) XXX_Size() int {
+	return xxx_messageInfo_ActionConfigurationSchema_Response.Size(m)
+}
+func (m *ActionConfigurationSchema_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionConfigurationSchema_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionConfigurationSchema_Response proto.InternalMessageInfo
+
+func (m *ActionConfigurationSchema_Response) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *ActionConfigurationSchema_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ActionOutputType struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionOutputType) Reset()         { *m = ActionOutputType{} }
+func (m *ActionOutputType) String() string { return proto.CompactTextString(m) }
+func (*ActionOutputType) ProtoMessage()    {}
+func (*ActionOutputType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{11}
+}
+
+func (m *ActionOutputType) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionOutputType.Unmarshal(m, b)
+}
+func (m *ActionOutputType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionOutputType.Marshal(b, m, deterministic)
+}
+func (m *ActionOutputType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionOutputType.Merge(m, src)
+}
+func (m *ActionOutputType) XXX_Size() int {
+	return xxx_messageInfo_ActionOutputType.Size(m)
+}
+func (m *ActionOutputType) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionOutputType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionOutputType proto.InternalMessageInfo
+
+type ActionOutputType_Request struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionOutputType_Request) Reset()         { *m = ActionOutputType_Request{} }
+func (m *ActionOutputType_Request) String() string { return proto.CompactTextString(m) }
+func (*ActionOutputType_Request) ProtoMessage()    {}
+func (*ActionOutputType_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{11, 0}
+}
+
+func (m *ActionOutputType_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionOutputType_Request.Unmarshal(m, b)
+}
+func (m *ActionOutputType_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionOutputType_Request.Marshal(b, m, deterministic)
+}
+func (m *ActionOutputType_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionOutputType_Request.Merge(m, src)
+}
+func (m *ActionOutputType_Request) XXX_Size() int {
+	return xxx_messageInfo_ActionOutputType_Request.Size(m)
+}
+func (m *ActionOutputType_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionOutputType_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionOutputType_Request proto.InternalMessageInfo
+
+func (m *ActionOutputType_Request) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ActionOutputType_Response struct {
+	Type                 []byte        `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ActionOutputType_Response) Reset()         { *m = ActionOutputType_Response{} }
+func (m *ActionOutputType_Response) String() string { return proto.CompactTextString(m) }
+func (*ActionOutputType_Response) ProtoMessage()    {}
+func (*ActionOutputType_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{11, 1}
+}
+
+func (m *ActionOutputType_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionOutputType_Response.Unmarshal(m, b)
+}
+func (m *ActionOutputType_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionOutputType_Response.Marshal(b, m, deterministic)
+}
+func (m *ActionOutputType_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionOutputType_Response.Merge(m, src)
+}
+func (m *ActionOutputType_Response) XXX_Size() int {
+	return xxx_messageInfo_ActionOutputType_Response.Size(m)
+}
+func (m *ActionOutputType_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionOutputType_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionOutputType_Response proto.InternalMessageInfo
+
+func (m *ActionOutputType_Response) GetType() []byte {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *ActionOutputType_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type TriggerKeyNames struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerKeyNames) Reset()         { *m = TriggerKeyNames{} }
+func (m *TriggerKeyNames) String() string { return proto.CompactTextString(m) }
+func (*TriggerKeyNames) ProtoMessage()    {}
+func (*TriggerKeyNames) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{12}
+}
+
+func (m *TriggerKeyNames) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerKeyNames.Unmarshal(m, b)
+}
+func (m *TriggerKeyNames) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerKeyNames.Marshal(b, m, deterministic)
+}
+func (m *TriggerKeyNames) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerKeyNames.Merge(m, src)
+}
+func (m *TriggerKeyNames) XXX_Size() int {
+	return xxx_messageInfo_TriggerKeyNames.Size(m)
+}
+func (m *TriggerKeyNames) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerKeyNames.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerKeyNames proto.InternalMessageInfo
+
+type TriggerKeyNames_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerKeyNames_Request) Reset()         { *m = TriggerKeyNames_Request{} }
+func (m *TriggerKeyNames_Request) String() string { return proto.CompactTextString(m) }
+func (*TriggerKeyNames_Request) ProtoMessage()    {}
+func (*TriggerKeyNames_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{12, 0}
+}
+
+func (m *TriggerKeyNames_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerKeyNames_Request.Unmarshal(m, b)
+}
+func (m *TriggerKeyNames_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerKeyNames_Request.Marshal(b, m, deterministic)
+}
+func (m *TriggerKeyNames_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerKeyNames_Request.Merge(m, src)
+}
+func (m *TriggerKeyNames_Request) XXX_Size() int {
+	return xxx_messageInfo_TriggerKeyNames_Request.Size(m)
+}
+func (m *TriggerKeyNames_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerKeyNames_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerKeyNames_Request proto.InternalMessageInfo
+
+type TriggerKeyNames_Response struct {
+	Names                []string      `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TriggerKeyNames_Response) Reset()         { *m = TriggerKeyNames_Response{} }
+func (m *TriggerKeyNames_Response) String() string { return proto.CompactTextString(m) }
+func (*TriggerKeyNames_Response) ProtoMessage()    {}
+func (*TriggerKeyNames_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{12, 1}
+}
+
+func (m *TriggerKeyNames_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerKeyNames_Response.Unmarshal(m, b)
+}
+func (m *TriggerKeyNames_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerKeyNames_Response.Marshal(b, m, deterministic)
+}
+func (m *TriggerKeyNames_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerKeyNames_Response.Merge(m, src)
+}
+func (m *TriggerKeyNames_Response) XXX_Size() int {
+	return xxx_messageInfo_TriggerKeyNames_Response.Size(m)
+}
+func (m *TriggerKeyNames_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerKeyNames_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerKeyNames_Response proto.InternalMessageInfo
+
+func (m *TriggerKeyNames_Response) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *TriggerKeyNames_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type TriggerConfigurationSchema struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerConfigurationSchema) Reset()         { *m = TriggerConfigurationSchema{} }
+func (m *TriggerConfigurationSchema) String() string { return proto.CompactTextString(m) }
+func (*TriggerConfigurationSchema) ProtoMessage()    {}
+func (*TriggerConfigurationSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{13}
+}
+
+func (m *TriggerConfigurationSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerConfigurationSchema.Unmarshal(m, b)
+}
+func (m *TriggerConfigurationSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerConfigurationSchema.Marshal(b, m, deterministic)
+}
+func (m *TriggerConfigurationSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerConfigurationSchema.Merge(m, src)
+}
+func (m *TriggerConfigurationSchema) XXX_Size() int {
+	return xxx_messageInfo_TriggerConfigurationSchema.Size(m)
+}
+func (m *TriggerConfigurationSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerConfigurationSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerConfigurationSchema proto.InternalMessageInfo
+
+type TriggerConfigurationSchema_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerConfigurationSchema_Request) Reset()         { *m = TriggerConfigurationSchema_Request{} }
+func (m *TriggerConfigurationSchema_Request) String() string { return proto.CompactTextString(m) }
+func (*TriggerConfigurationSchema_Request) ProtoMessage()    {}
+func (*TriggerConfigurationSchema_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{13, 0}
+}
+
+func (m *TriggerConfigurationSchema_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerConfigurationSchema_Request.Unmarshal(m, b)
+}
+func (m *TriggerConfigurationSchema_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerConfigurationSchema_Request.Marshal(b, m, deterministic)
+}
+func (m *TriggerConfigurationSchema_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerConfigurationSchema_Request.Merge(m, src)
+}
+func (m *TriggerConfigurationSchema_Request) XXX_Size() int {
+	return xxx_messageInfo_TriggerConfigurationSchema_Request.Size(m)
+}
+func (m *TriggerConfigurationSchema_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerConfigurationSchema_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerConfigurationSchema_Request proto.InternalMessageInfo
+
+type TriggerConfigurationSchema_Response struct {
+	Schema               []byte        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TriggerConfigurationSchema_Response) Reset()         { *m = TriggerConfigurationSchema_Response{} }
+func (m *TriggerConfigurationSchema_Response) String() string { return proto.CompactTextString(m) }
+func (*TriggerConfigurationSchema_Response) ProtoMessage()    {}
+func (*TriggerConfigurationSchema_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{13, 1}
+}
+
+func (m *TriggerConfigurationSchema_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerConfigurationSchema_Response.Unmarshal(m, b)
+}
+func (m *TriggerConfigurationSchema_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerConfigurationSchema_Response.Marshal(b, m, deterministic)
+}
+func (m *TriggerConfigurationSchema_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerConfigurationSchema_Response.Merge(m, src)
+}
+func (m *TriggerConfigurationSchema_Response) XXX_Size() int {
+	return xxx_messageInfo_TriggerConfigurationSchema_Response.Size(m)
+}
+func (m *TriggerConfigurationSchema_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerConfigurationSchema_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerConfigurationSchema_Response proto.InternalMessageInfo
+
+func (m *TriggerConfigurationSchema_Response) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *TriggerConfigurationSchema_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type MapPayloadToTriggerKey struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MapPayloadToTriggerKey) Reset()         { *m = MapPayloadToTriggerKey{} }
+func (m *MapPayloadToTriggerKey) String() string { return proto.CompactTextString(m) }
+func (*MapPayloadToTriggerKey) ProtoMessage()    {}
+func (*MapPayloadToTriggerKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{14}
+}
+
+func (m *MapPayloadToTriggerKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MapPayloadToTriggerKey.Unmarshal(m, b)
+}
+func (m *MapPayloadToTriggerKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MapPayloadToTriggerKey.Marshal(b, m, deterministic)
+}
+func (m *MapPayloadToTriggerKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapPayloadToTriggerKey.Merge(m, src)
+}
+func (m *MapPayloadToTriggerKey) XXX_Size() int {
+	return xxx_messageInfo_MapPayloadToTriggerKey.Size(m)
+}
+func (m *MapPayloadToTriggerKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapPayloadToTriggerKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MapPayloadToTriggerKey proto.InternalMessageInfo
+
+type MapPayloadToTriggerKey_Request struct {
+	Payload              []byte   `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MapPayloadToTriggerKey_Request) Reset()         { *m = MapPayloadToTriggerKey_Request{} }
+func (m *MapPayloadToTriggerKey_Request) String() string { return proto.CompactTextString(m) }
+func (*MapPayloadToTriggerKey_Request) ProtoMessage()    {}
+func (*MapPayloadToTriggerKey_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{14, 0}
+}
+
+func (m *MapPayloadToTriggerKey_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MapPayloadToTriggerKey_Request.Unmarshal(m, b)
+}
+func (m *MapPayloadToTriggerKey_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MapPayloadToTriggerKey_Request.Marshal(b, m, deterministic)
+}
+func (m *MapPayloadToTriggerKey_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapPayloadToTriggerKey_Request.Merge(m, src)
+}
+func (m *MapPayloadToTriggerKey_Request) XXX_Size() int {
+	return xxx_messageInfo_MapPayloadToTriggerKey_Request.Size(m)
+}
+func (m *MapPayloadToTriggerKey_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapPayloadToTriggerKey_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MapPayloadToTriggerKey_Request proto.InternalMessageInfo
+
+func (m *MapPayloadToTriggerKey_Request) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type MapPayloadToTriggerKey_Response struct {
+	Key                  string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MapPayloadToTriggerKey_Response) Reset()         { *m = MapPayloadToTriggerKey_Response{} }
+func (m *MapPayloadToTriggerKey_Response) String() string { return proto.CompactTextString(m) }
+func (*MapPayloadToTriggerKey_Response) ProtoMessage()    {}
+func (*MapPayloadToTriggerKey_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{14, 1}
+}
+
+func (m *MapPayloadToTriggerKey_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MapPayloadToTriggerKey_Response.Unmarshal(m, b)
+}
+func (m *MapPayloadToTriggerKey_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MapPayloadToTriggerKey_Response.Marshal(b, m, deterministic)
+}
+func (m *MapPayloadToTriggerKey_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapPayloadToTriggerKey_Response.Merge(m, src)
+}
+func (m *MapPayloadToTriggerKey_Response) XXX_Size() int {
+	return xxx_messageInfo_MapPayloadToTriggerKey_Response.Size(m)
+}
+func (m *MapPayloadToTriggerKey_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapPayloadToTriggerKey_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MapPayloadToTriggerKey_Response proto.InternalMessageInfo
+
+func (m *MapPayloadToTriggerKey_Response) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MapPayloadToTriggerKey_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ActionMetadata struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionMetadata) Reset()         { *m = ActionMetadata{} }
+func (m *ActionMetadata) String() string { return proto.CompactTextString(m) }
+func (*ActionMetadata) ProtoMessage()    {}
+func (*ActionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{15}
+}
+
+func (m *ActionMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionMetadata.Unmarshal(m, b)
+}
+func (m *ActionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionMetadata.Marshal(b, m, deterministic)
+}
+func (m *ActionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionMetadata.Merge(m, src)
+}
+func (m *ActionMetadata) XXX_Size() int {
+	return xxx_messageInfo_ActionMetadata.Size(m)
+}
+func (m *ActionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionMetadata proto.InternalMessageInfo
+
+type ActionMetadata_Request struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionMetadata_Request) Reset()         { *m = ActionMetadata_Request{} }
+func (m *ActionMetadata_Request) String() string { return proto.CompactTextString(m) }
+func (*ActionMetadata_Request) ProtoMessage()    {}
+func (*ActionMetadata_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{15, 0}
+}
+
+func (m *ActionMetadata_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionMetadata_Request.Unmarshal(m, b)
+}
+func (m *ActionMetadata_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionMetadata_Request.Marshal(b, m, deterministic)
+}
+func (m *ActionMetadata_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionMetadata_Request.Merge(m, src)
+}
+func (m *ActionMetadata_Request) XXX_Size() int {
+	return xxx_messageInfo_ActionMetadata_Request.Size(m)
+}
+func (m *ActionMetadata_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionMetadata_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionMetadata_Request proto.InternalMessageInfo
+
+func (m *ActionMetadata_Request) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ActionMetadata_Response struct {
+	Metadata             *Metadata     `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ActionMetadata_Response) Reset()         { *m = ActionMetadata_Response{} }
+func (m *ActionMetadata_Response) String() string { return proto.CompactTextString(m) }
+func (*ActionMetadata_Response) ProtoMessage()    {}
+func (*ActionMetadata_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{15, 1}
+}
+
+func (m *ActionMetadata_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionMetadata_Response.Unmarshal(m, b)
+}
+func (m *ActionMetadata_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionMetadata_Response.Marshal(b, m, deterministic)
+}
+func (m *ActionMetadata_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionMetadata_Response.Merge(m, src)
+}
+func (m *ActionMetadata_Response) XXX_Size() int {
+	return xxx_messageInfo_ActionMetadata_Response.Size(m)
+}
+func (m *ActionMetadata_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionMetadata_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionMetadata_Response proto.InternalMessageInfo
+
+func (m *ActionMetadata_Response) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ActionMetadata_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type TriggerMetadata struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerMetadata) Reset()         { *m = TriggerMetadata{} }
+func (m *TriggerMetadata) String() string { return proto.CompactTextString(m) }
+func (*TriggerMetadata) ProtoMessage()    {}
+func (*TriggerMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{16}
+}
+
+func (m *TriggerMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerMetadata.Unmarshal(m, b)
+}
+func (m *TriggerMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerMetadata.Marshal(b, m, deterministic)
+}
+func (m *TriggerMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerMetadata.Merge(m, src)
+}
+func (m *TriggerMetadata) XXX_Size() int {
+	return xxx_messageInfo_TriggerMetadata.Size(m)
+}
+func (m *TriggerMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerMetadata proto.InternalMessageInfo
+
+type TriggerMetadata_Request struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerMetadata_Request) Reset()         { *m = TriggerMetadata_Request{} }
+func (m *TriggerMetadata_Request) String() string { return proto.CompactTextString(m) }
+func (*TriggerMetadata_Request) ProtoMessage()    {}
+func (*TriggerMetadata_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{16, 0}
+}
+
+func (m *TriggerMetadata_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerMetadata_Request.Unmarshal(m, b)
+}
+func (m *TriggerMetadata_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerMetadata_Request.Marshal(b, m, deterministic)
+}
+func (m *TriggerMetadata_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerMetadata_Request.Merge(m, src)
+}
+func (m *TriggerMetadata_Request) XXX_Size() int {
+	return xxx_messageInfo_TriggerMetadata_Request.Size(m)
+}
+func (m *TriggerMetadata_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerMetadata_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerMetadata_Request proto.InternalMessageInfo
+
+func (m *TriggerMetadata_Request) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type TriggerMetadata_Response struct {
+	Metadata             *Metadata     `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TriggerMetadata_Response) Reset()         { *m = TriggerMetadata_Response{} }
+func (m *TriggerMetadata_Response) String() string { return proto.CompactTextString(m) }
+func (*TriggerMetadata_Response) ProtoMessage()    {}
+func (*TriggerMetadata_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{16, 1}
+}
+
+func (m *TriggerMetadata_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerMetadata_Response.Unmarshal(m, b)
+}
+func (m *TriggerMetadata_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerMetadata_Response.Marshal(b, m, deterministic)
+}
+func (m *TriggerMetadata_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerMetadata_Response.Merge(m, src)
+}
+func (m *TriggerMetadata_Response) XXX_Size() int {
+	return xxx_messageInfo_TriggerMetadata_Response.Size(m)
+}
+func (m *TriggerMetadata_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerMetadata_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerMetadata_Response proto.InternalMessageInfo
+
+func (m *TriggerMetadata_Response) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TriggerMetadata_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type TriggerOutputType struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerOutputType) Reset()         { *m = TriggerOutputType{} }
+func (m *TriggerOutputType) String() string { return proto.CompactTextString(m) }
+func (*TriggerOutputType) ProtoMessage()    {}
+func (*TriggerOutputType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{17}
+}
+
+func (m *TriggerOutputType) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerOutputType.Unmarshal(m, b)
+}
+func (m *TriggerOutputType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerOutputType.Marshal(b, m, deterministic)
+}
+func (m *TriggerOutputType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOutputType.Merge(m, src)
+}
+func (m *TriggerOutputType) XXX_Size() int {
+	return xxx_messageInfo_TriggerOutputType.Size(m)
+}
+func (m *TriggerOutputType) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOutputType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOutputType proto.InternalMessageInfo
+
+type TriggerOutputType_Request struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerOutputType_Request) Reset()         { *m = TriggerOutputType_Request{} }
+func (m *TriggerOutputType_Request) String() string { return proto.CompactTextString(m) }
+func (*TriggerOutputType_Request) ProtoMessage()    {}
+func (*TriggerOutputType_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{17, 0}
+}
+
+func (m *TriggerOutputType_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerOutputType_Request.Unmarshal(m, b)
+}
+func (m *TriggerOutputType_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerOutputType_Request.Marshal(b, m, deterministic)
+}
+func (m *TriggerOutputType_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOutputType_Request.Merge(m, src)
+}
+func (m *TriggerOutputType_Request) XXX_Size() int {
+	return xxx_messageInfo_TriggerOutputType_Request.Size(m)
+}
+func (m *TriggerOutputType_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOutputType_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOutputType_Request proto.InternalMessageInfo
+
+func (m *TriggerOutputType_Request) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type TriggerOutputType_Response struct {
+	Type                 []byte        `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TriggerOutputType_Response) Reset()         { *m = TriggerOutputType_Response{} }
+func (m *TriggerOutputType_Response) String() string { return proto.CompactTextString(m) }
+func (*TriggerOutputType_Response) ProtoMessage()    {}
+func (*TriggerOutputType_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{17, 1}
+}
+
+func (m *TriggerOutputType_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerOutputType_Response.Unmarshal(m, b)
+}
+func (m *TriggerOutputType_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerOutputType_Response.Marshal(b, m, deterministic)
+}
+func (m *TriggerOutputType_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOutputType_Response.Merge(m, src)
+}
+func (m *TriggerOutputType_Response) XXX_Size() int {
+	return xxx_messageInfo_TriggerOutputType_Response.Size(m)
+}
+func (m *TriggerOutputType_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOutputType_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOutputType_Response proto.InternalMessageInfo
+
+func (m *TriggerOutputType_Response) GetType() []byte {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *TriggerOutputType_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type CreateSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSubscription) Reset()         { *m = CreateSubscription{} }
+func (m *CreateSubscription) String() string { return proto.CompactTextString(m) }
+func (*CreateSubscription) ProtoMessage()    {}
+func (*CreateSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{18}
+}
+
+func (m *CreateSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSubscription.Unmarshal(m, b)
+}
+func (m *CreateSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSubscription.Marshal(b, m, deterministic)
+}
+func (m *CreateSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSubscription.Merge(m, src)
+}
+func (m *CreateSubscription) XXX_Size() int {
+	return xxx_messageInfo_CreateSubscription.Size(m)
+}
+func (m *CreateSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSubscription proto.InternalMessageInfo
+
+type CreateSubscription_Request struct {
+	ContextId            string   `protobuf:"bytes,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	Input                []byte   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSubscription_Request) Reset()         { *m = CreateSubscription_Request{} }
+func (m *CreateSubscription_Request) String() string { return proto.CompactTextString(m) }
+func (*CreateSubscription_Request) ProtoMessage()    {}
+func (*CreateSubscription_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{18, 0}
+}
+
+func (m *CreateSubscription_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSubscription_Request.Unmarshal(m, b)
+}
+func (m *CreateSubscription_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSubscription_Request.Marshal(b, m, deterministic)
+}
+func (m *CreateSubscription_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSubscription_Request.Merge(m, src)
+}
+func (m *CreateSubscription_Request) XXX_Size() int {
+	return xxx_messageInfo_CreateSubscription_Request.Size(m)
+}
+func (m *CreateSubscription_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSubscription_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSubscription_Request proto.InternalMessageInfo
+
+func (m *CreateSubscription_Request) GetContextId() string {
+	if m != nil {
+		return m.ContextId
+	}
+	return ""
+}
+
+func (m *CreateSubscription_Request) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+type CreateSubscription_Response struct {
+	State                []byte        `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CreateSubscription_Response) Reset()         { *m = CreateSubscription_Response{} }
+func (m *CreateSubscription_Response) String() string { return proto.CompactTextString(m) }
+func (*CreateSubscription_Response) ProtoMessage()    {}
+func (*CreateSubscription_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{18, 1}
+}
+
+func (m *CreateSubscription_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSubscription_Response.Unmarshal(m, b)
+}
+func (m *CreateSubscription_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSubscription_Response.Marshal(b, m, deterministic)
+}
+func (m *CreateSubscription_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSubscription_Response.Merge(m, src)
+}
+func (m *CreateSubscription_Response) XXX_Size() int {
+	return xxx_messageInfo_CreateSubscription_Response.Size(m)
+}
+func (m *CreateSubscription_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSubscription_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSubscription_Response proto.InternalMessageInfo
+
+func (m *CreateSubscription_Response) GetState() []byte {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *CreateSubscription_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ReadSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadSubscription) Reset()         { *m = ReadSubscription{} }
+func (m *ReadSubscription) String() string { return proto.CompactTextString(m) }
+func (*ReadSubscription) ProtoMessage()    {}
+func (*ReadSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{19}
+}
+
+func (m *ReadSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadSubscription.Unmarshal(m, b)
+}
+func (m *ReadSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadSubscription.Marshal(b, m, deterministic)
+}
+func (m *ReadSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadSubscription.Merge(m, src)
+}
+func (m *ReadSubscription) XXX_Size() int {
+	return xxx_messageInfo_ReadSubscription.Size(m)
+}
+func (m *ReadSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadSubscription proto.InternalMessageInfo
+
+type ReadSubscription_Request struct {
+	ContextId            string   `protobuf:"bytes,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	SubscriptionId       string   `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadSubscription_Request) Reset()         { *m = ReadSubscription_Request{} }
+func (m *ReadSubscription_Request) String() string { return proto.CompactTextString(m) }
+func (*ReadSubscription_Request) ProtoMessage()    {}
+func (*ReadSubscription_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{19, 0}
+}
+
+func (m *ReadSubscription_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadSubscription_Request.Unmarshal(m, b)
+}
+func (m *ReadSubscription_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadSubscription_Request.Marshal(b, m, deterministic)
+}
+func (m *ReadSubscription_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadSubscription_Request.Merge(m, src)
+}
+func (m *ReadSubscription_Request) XXX_Size() int {
+	return xxx_messageInfo_ReadSubscription_Request.Size(m)
+}
+func (m *ReadSubscription_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadSubscription_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadSubscription_Request proto.InternalMessageInfo
+
+func (m *ReadSubscription_Request) GetContextId() string {
+	if m != nil {
+		return m.ContextId
+	}
+	return ""
+}
+
+func (m *ReadSubscription_Request) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+type ReadSubscription_Response struct {
+	State                []byte        `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReadSubscription_Response) Reset()         { *m = ReadSubscription_Response{} }
+func (m *ReadSubscription_Response) String() string { return proto.CompactTextString(m) }
+func (*ReadSubscription_Response) ProtoMessage()    {}
+func (*ReadSubscription_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{19, 1}
+}
+
+func (m *ReadSubscription_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadSubscription_Response.Unmarshal(m, b)
+}
+func (m *ReadSubscription_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadSubscription_Response.Marshal(b, m, deterministic)
+}
+func (m *ReadSubscription_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadSubscription_Response.Merge(m, src)
+}
+func (m *ReadSubscription_Response) XXX_Size() int {
+	return xxx_messageInfo_ReadSubscription_Response.Size(m)
+}
+func (m *ReadSubscription_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadSubscription_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadSubscription_Response proto.InternalMessageInfo
+
+func (m *ReadSubscription_Response) GetState() []byte {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ReadSubscription_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type UpdateSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSubscription) Reset()         { *m = UpdateSubscription{} }
+func (m *UpdateSubscription) String() string { return proto.CompactTextString(m) }
+func (*UpdateSubscription) ProtoMessage()    {}
+func (*UpdateSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{20}
+}
+
+func (m *UpdateSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSubscription.Unmarshal(m, b)
+}
+func (m *UpdateSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSubscription.Marshal(b, m, deterministic)
+}
+func (m *UpdateSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSubscription.Merge(m, src)
+}
+func (m *UpdateSubscription) XXX_Size() int {
+	return xxx_messageInfo_UpdateSubscription.Size(m)
+}
+func (m *UpdateSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSubscription proto.InternalMessageInfo
+
+type UpdateSubscription_Request struct {
+	ContextId            string   `protobuf:"bytes,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	SubscriptionId       string   `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Input                []byte   `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSubscription_Request) Reset()         { *m = UpdateSubscription_Request{} }
+func (m *UpdateSubscription_Request) String() string { return proto.CompactTextString(m) }
+func (*UpdateSubscription_Request) ProtoMessage()    {}
+func (*UpdateSubscription_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{20, 0}
+}
+
+func (m *UpdateSubscription_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSubscription_Request.Unmarshal(m, b)
+}
+func (m *UpdateSubscription_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSubscription_Request.Marshal(b, m, deterministic)
+}
+func (m *UpdateSubscription_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSubscription_Request.Merge(m, src)
+}
+func (m *UpdateSubscription_Request) XXX_Size() int {
+	return xxx_messageInfo_UpdateSubscription_Request.Size(m)
+}
+func (m *UpdateSubscription_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSubscription_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSubscription_Request proto.InternalMessageInfo
+
+func (m *UpdateSubscription_Request) GetContextId() string {
+	if m != nil {
+		return m.ContextId
+	}
+	return ""
+}
+
+func (m *UpdateSubscription_Request) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+func (m *UpdateSubscription_Request) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+type UpdateSubscription_Response struct {
+	State                []byte        `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpdateSubscription_Response) Reset()         { *m = UpdateSubscription_Response{} }
+func (m *UpdateSubscription_Response) String() string { return proto.CompactTextString(m) }
+func (*UpdateSubscription_Response) ProtoMessage()    {}
+func (*UpdateSubscription_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{20, 1}
+}
+
+func (m *UpdateSubscription_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSubscription_Response.Unmarshal(m, b)
+}
+func (m *UpdateSubscription_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSubscription_Response.Marshal(b, m, deterministic)
+}
+func (m *UpdateSubscription_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSubscription_Response.Merge(m, src)
+}
+func (m *UpdateSubscription_Response) XXX_Size() int {
+	return xxx_messageInfo_UpdateSubscription_Response.Size(m)
+}
+func (m *UpdateSubscription_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSubscription_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSubscription_Response proto.InternalMessageInfo
+
+func (m *UpdateSubscription_Response) GetState() []byte {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *UpdateSubscription_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type DeleteSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSubscription) Reset()         { *m = DeleteSubscription{} }
+func (m *DeleteSubscription) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscription) ProtoMessage()    {}
+func (*DeleteSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{21}
+}
+
+func (m *DeleteSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscription.Unmarshal(m, b)
+}
+func (m *DeleteSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscription.Marshal(b, m, deterministic)
+}
+func (m *DeleteSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscription.Merge(m, src)
+}
+func (m *DeleteSubscription) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscription.Size(m)
+}
+func (m *DeleteSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscription proto.InternalMessageInfo
+
+type DeleteSubscription_Request struct {
+	ContextId            string   `protobuf:"bytes,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	SubscriptionId       string   `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSubscription_Request) Reset()         { *m = DeleteSubscription_Request{} }
+func (m *DeleteSubscription_Request) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscription_Request) ProtoMessage()    {}
+func (*DeleteSubscription_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{21, 0}
+}
+
+func (m *DeleteSubscription_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscription_Request.Unmarshal(m, b)
+}
+func (m *DeleteSubscription_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscription_Request.Marshal(b, m, deterministic)
+}
+func (m *DeleteSubscription_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscription_Request.Merge(m, src)
+}
+func (m *DeleteSubscription_Request) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscription_Request.Size(m)
+}
+func (m *DeleteSubscription_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscription_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscription_Request proto.InternalMessageInfo
+
+func (m *DeleteSubscription_Request) GetContextId() string {
+	if m != nil {
+		return m.ContextId
+	}
+	return ""
+}
+
+func (m *DeleteSubscription_Request) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+type DeleteSubscription_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DeleteSubscription_Response) Reset()         { *m = DeleteSubscription_Response{} }
+func (m *DeleteSubscription_Response) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscription_Response) ProtoMessage()    {}
+func (*DeleteSubscription_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{21, 1}
+}
+
+func (m *DeleteSubscription_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscription_Response.Unmarshal(m, b)
+}
+func (m *DeleteSubscription_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscription_Response.Marshal(b, m, deterministic)
+}
+func (m *DeleteSubscription_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscription_Response.Merge(m, src)
+}
+func (m *DeleteSubscription_Response) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscription_Response.Size(m)
+}
+func (m *DeleteSubscription_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscription_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscription_Response proto.InternalMessageInfo
+
+func (m *DeleteSubscription_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("switchboard.provider.v3.ErrorKind", ErrorKind_name, ErrorKind_value)
+	proto.RegisterEnum("switchboard.provider.v3.Diagnostic_Severity", Diagnostic_Severity_name, Diagnostic_Severity_value)
+	proto.RegisterType((*Diagnostic)(nil), "switchboard.provider.v3.Diagnostic")
+	proto.RegisterType((*AttributePath)(nil), "switchboard.provider.v3.AttributePath")
+	proto.RegisterType((*AttributePath_Step)(nil), "switchboard.provider.v3.AttributePath.Step")
+	proto.RegisterType((*Range)(nil), "switchboard.provider.v3.Range")
+	proto.RegisterType((*Range_Pos)(nil), "switchboard.provider.v3.Range.Pos")
+	proto.RegisterType((*Metadata)(nil), "switchboard.provider.v3.Metadata")
+	proto.RegisterType((*UserConfig)(nil), "switchboard.provider.v3.UserConfig")
+	proto.RegisterType((*UserConfig_Request)(nil), "switchboard.provider.v3.UserConfig.Request")
+	proto.RegisterType((*UserConfig_Response)(nil), "switchboard.provider.v3.UserConfig.Response")
+	proto.RegisterMapType((map[string][]byte)(nil), "switchboard.provider.v3.UserConfig.Response.ConfigEntry")
+	proto.RegisterType((*GlobalConfig)(nil), "switchboard.provider.v3.GlobalConfig")
+	proto.RegisterType((*GlobalConfig_Request)(nil), "switchboard.provider.v3.GlobalConfig.Request")
+	proto.RegisterType((*GlobalConfig_Response)(nil), "switchboard.provider.v3.GlobalConfig.Response")
+	proto.RegisterType((*Init)(nil), "switchboard.provider.v3.Init")
+	proto.RegisterType((*Init_Request)(nil), "switchboard.provider.v3.Init.Request")
+	proto.RegisterType((*Init_Response)(nil), "switchboard.provider.v3.Init.Response")
+	proto.RegisterType((*InitSchema)(nil), "switchboard.provider.v3.InitSchema")
+	proto.RegisterType((*InitSchema_Request)(nil), "switchboard.provider.v3.InitSchema.Request")
+	proto.RegisterType((*InitSchema_Response)(nil), "switchboard.provider.v3.InitSchema.Response")
+	proto.RegisterType((*ActionNames)(nil), "switchboard.provider.v3.ActionNames")
+	proto.RegisterType((*ActionNames_Request)(nil), "switchboard.provider.v3.ActionNames.Request")
+	proto.RegisterType((*ActionNames_Response)(nil), "switchboard.provider.v3.ActionNames.Response")
+	proto.RegisterType((*ActionEvaluate)(nil), "switchboard.provider.v3.ActionEvaluate")
+	proto.RegisterType((*ActionEvaluate_Request)(nil), "switchboard.provider.v3.ActionEvaluate.Request")
+	proto.RegisterType((*ActionEvaluate_Response)(nil), "switchboard.provider.v3.ActionEvaluate.Response")
+	proto.RegisterType((*ActionConfigurationSchema)(nil), "switchboard.provider.v3.ActionConfigurationSchema")
+	proto.RegisterType((*ActionConfigurationSchema_Request)(nil), "switchboard.provider.v3.ActionConfigurationSchema.Request")
+	proto.RegisterType((*ActionConfigurationSchema_Response)(nil), "switchboard.provider.v3.ActionConfigurationSchema.Response")
+	proto.RegisterType((*ActionOutputType)(nil), "switchboard.provider.v3.ActionOutputType")
+	proto.RegisterType((*ActionOutputType_Request)(nil), "switchboard.provider.v3.ActionOutputType.Request")
+	proto.RegisterType((*ActionOutputType_Response)(nil), "switchboard.provider.v3.ActionOutputType.Response")
+	proto.RegisterType((*TriggerKeyNames)(nil), "switchboard.provider.v3.TriggerKeyNames")
+	proto.RegisterType((*TriggerKeyNames_Request)(nil), "switchboard.provider.v3.TriggerKeyNames.Request")
+	proto.RegisterType((*TriggerKeyNames_Response)(nil), "switchboard.provider.v3.TriggerKeyNames.Response")
+	proto.RegisterType((*TriggerConfigurationSchema)(nil), "switchboard.provider.v3.TriggerConfigurationSchema")
+	proto.RegisterType((*TriggerConfigurationSchema_Request)(nil), "switchboard.provider.v3.TriggerConfigurationSchema.Request")
+	proto.RegisterType((*TriggerConfigurationSchema_Response)(nil), "switchboard.provider.v3.TriggerConfigurationSchema.Response")
+	proto.RegisterType((*MapPayloadToTriggerKey)(nil), "switchboard.provider.v3.MapPayloadToTriggerKey")
+	proto.RegisterType((*MapPayloadToTriggerKey_Request)(nil), "switchboard.provider.v3.MapPayloadToTriggerKey.Request")
+	proto.RegisterType((*MapPayloadToTriggerKey_Response)(nil), "switchboard.provider.v3.MapPayloadToTriggerKey.Response")
+	proto.RegisterType((*ActionMetadata)(nil), "switchboard.provider.v3.ActionMetadata")
+	proto.RegisterType((*ActionMetadata_Request)(nil), "switchboard.provider.v3.ActionMetadata.Request")
+	proto.RegisterType((*ActionMetadata_Response)(nil), "switchboard.provider.v3.ActionMetadata.Response")
+	proto.RegisterType((*TriggerMetadata)(nil), "switchboard.provider.v3.TriggerMetadata")
+	proto.RegisterType((*TriggerMetadata_Request)(nil), "switchboard.provider.v3.TriggerMetadata.Request")
+	proto.RegisterType((*TriggerMetadata_Response)(nil), "switchboard.provider.v3.TriggerMetadata.Response")
+	proto.RegisterType((*TriggerOutputType)(nil), "switchboard.provider.v3.TriggerOutputType")
+	proto.RegisterType((*TriggerOutputType_Request)(nil), "switchboard.provider.v3.TriggerOutputType.Request")
+	proto.RegisterType((*TriggerOutputType_Response)(nil), "switchboard.provider.v3.TriggerOutputType.Response")
+	proto.RegisterType((*CreateSubscription)(nil), "switchboard.provider.v3.CreateSubscription")
+	proto.RegisterType((*CreateSubscription_Request)(nil), "switchboard.provider.v3.CreateSubscription.Request")
+	proto.RegisterType((*CreateSubscription_Response)(nil), "switchboard.provider.v3.CreateSubscription.Response")
+	proto.RegisterType((*ReadSubscription)(nil), "switchboard.provider.v3.ReadSubscription")
+	proto.RegisterType((*ReadSubscription_Request)(nil), "switchboard.provider.v3.ReadSubscription.Request")
+	proto.RegisterType((*ReadSubscription_Response)(nil), "switchboard.provider.v3.ReadSubscription.Response")
+	proto.RegisterType((*UpdateSubscription)(nil), "switchboard.provider.v3.UpdateSubscription")
+	proto.RegisterType((*UpdateSubscription_Request)(nil), "switchboard.provider.v3.UpdateSubscription.Request")
+	proto.RegisterType((*UpdateSubscription_Response)(nil), "switchboard.provider.v3.UpdateSubscription.Response")
+	proto.RegisterType((*DeleteSubscription)(nil), "switchboard.provider.v3.DeleteSubscription")
+	proto.RegisterType((*DeleteSubscription_Request)(nil), "switchboard.provider.v3.DeleteSubscription.Request")
+	proto.RegisterType((*DeleteSubscription_Response)(nil), "switchboard.provider.v3.DeleteSubscription.Response")
+}
+
+func init() {
+	proto.RegisterFile("provider.proto", fileDescriptor_c6a9f3c02af3d1c8)
+}
+
+var fileDescriptor_c6a9f3c02af3d1c8 = []byte{
+	// 1742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0x24, 0x47,
+	0x15, 0xdf, 0xf2, 0xf8, 0xcf, 0xcc, 0x1b, 0xaf, 0xdd, 0xa9, 0xac, 0x96, 0x61, 0x50, 0x82, 0x33,
+	0x11, 0x89, 0x95, 0xec, 0x8e, 0x59, 0x3b, 0x80, 0x49, 0x00, 0x69, 0x76, 0x3d, 0xc9, 0x0e, 0xbb,
+	0x1e, 0x3b, 0xe5, 0x31, 0x11, 0xb9, 0x8c, 0x7a, 0xa6, 0xdf, 0x8e, 0x2b, 0xee, 0xe9, 0x6e, 0xaa,
+	0x6a, 0xbc, 0x19, 0x09, 0x29, 0x02, 0x89, 0x13, 0x17, 0x84, 0x40, 0xcb, 0x85, 0x03, 0x48, 0x7c,
+	0x00, 0xc4, 0x2d, 0x12, 0x07, 0x38, 0x70, 0x42, 0x7c, 0x00, 0xb8, 0x70, 0xe2, 0xc0, 0xa7, 0x40,
+	0x55, 0xfd, 0xd7, 0x9e, 0xbf, 0x5e, 0x19, 0x6f, 0x2e, 0xbb, 0xfd, 0xaa, 0x7e, 0xbf, 0x7a, 0xbf,
+	0xf7, 0xea, 0x75, 0xd5, 0x9b, 0x36, 0xac, 0x05, 0xc2, 0x3f, 0xe3, 0x0e, 0x8a, 0x6a, 0x20, 0x7c,
+	0xe5, 0xd3, 0x2f, 0xc9, 0xa7, 0x5c, 0x75, 0x4f, 0x3a, 0xbe, 0x2d, 0x9c, 0x6a, 0x32, 0x77, 0xb6,
+	0x53, 0xf9, 0x53, 0x0e, 0x60, 0x8f, 0xdb, 0x3d, 0xcf, 0x97, 0x8a, 0x77, 0xe9, 0x43, 0xc8, 0x4b,
+	0x3c, 0x43, 0xc1, 0xd5, 0xb0, 0x44, 0x36, 0xc8, 0xe6, 0xda, 0xf6, 0x9d, 0xea, 0x04, 0x6a, 0x35,
+	0xa5, 0x55, 0x8f, 0x22, 0x0e, 0x4b, 0xd8, 0xb4, 0x04, 0x2b, 0x72, 0xd0, 0xef, 0xdb, 0x62, 0x58,
+	0x5a, 0xd8, 0x20, 0x9b, 0x05, 0x16, 0x9b, 0xf4, 0x36, 0x2c, 0x3b, 0xa8, 0x6c, 0xee, 0x96, 0x72,
+	0x66, 0x22, 0xb2, 0xe8, 0x1e, 0x14, 0x6c, 0xa5, 0x04, 0xef, 0x0c, 0x14, 0x96, 0x16, 0x37, 0xc8,
+	0x66, 0x71, 0xfb, 0x8d, 0x89, 0xce, 0x6b, 0x31, 0xf2, 0xd0, 0x56, 0x27, 0x2c, 0x25, 0xd2, 0x5d,
+	0xed, 0xb7, 0xf3, 0x09, 0x76, 0x55, 0x69, 0xc9, 0xac, 0xf1, 0xea, 0xc4, 0x35, 0x98, 0xed, 0xf5,
+	0x90, 0xc5, 0x70, 0x4a, 0x61, 0xb1, 0xeb, 0x3b, 0x58, 0x5a, 0x36, 0xaa, 0xcc, 0x33, 0xfd, 0x26,
+	0x2c, 0x9e, 0x72, 0xcf, 0x29, 0xad, 0x98, 0x5c, 0x54, 0x26, 0x2e, 0x55, 0x17, 0xc2, 0x17, 0x8f,
+	0xb8, 0xe7, 0x30, 0x83, 0xa7, 0x77, 0x80, 0x0a, 0x54, 0x62, 0xd8, 0xb6, 0x9f, 0x28, 0x14, 0xed,
+	0x3e, 0x77, 0x5d, 0x2e, 0x4b, 0xf9, 0x0d, 0xb2, 0x99, 0x63, 0x96, 0x99, 0xa9, 0xe9, 0x89, 0x7d,
+	0x33, 0x5e, 0xd9, 0x82, 0x7c, 0x9c, 0x41, 0x5a, 0x84, 0x95, 0x46, 0xf3, 0x07, 0xb5, 0xc7, 0x8d,
+	0x3d, 0xeb, 0x06, 0x2d, 0xc0, 0x52, 0x9d, 0xb1, 0x03, 0x66, 0x11, 0x3d, 0xfe, 0x51, 0x8d, 0x35,
+	0x1b, 0xcd, 0x0f, 0xac, 0x85, 0xca, 0x7f, 0x09, 0xdc, 0x3c, 0x97, 0x01, 0x5a, 0x83, 0x25, 0xa9,
+	0x30, 0x90, 0x25, 0xb2, 0x91, 0xdb, 0x2c, 0x6e, 0xbf, 0x3d, 0x5f, 0xe2, 0xaa, 0x47, 0x0a, 0x03,
+	0x16, 0x32, 0xcb, 0xbf, 0x26, 0xb0, 0xa8, 0x6d, 0xfa, 0x26, 0xac, 0x25, 0xf9, 0x6c, 0x7b, 0x76,
+	0x1f, 0x4d, 0x29, 0x14, 0x1e, 0xde, 0x60, 0x37, 0x93, 0xf1, 0xa6, 0xdd, 0x47, 0x5a, 0x05, 0x8a,
+	0x2e, 0xf6, 0xd1, 0x53, 0xed, 0x53, 0x1c, 0xb6, 0xa5, 0x12, 0xdc, 0xeb, 0x85, 0xdb, 0xfd, 0xf0,
+	0x06, 0xb3, 0xa2, 0xb9, 0x47, 0x38, 0x3c, 0x32, 0x33, 0x74, 0x13, 0xd6, 0xb3, 0x78, 0xee, 0x29,
+	0x53, 0x02, 0x39, 0xbd, 0x72, 0x0a, 0x6e, 0x78, 0xea, 0x3e, 0xe8, 0x3a, 0x74, 0xb1, 0xab, 0x7c,
+	0x51, 0xf9, 0x27, 0x81, 0x25, 0xb3, 0x55, 0xb4, 0x0c, 0xf9, 0x27, 0xdc, 0xc5, 0x54, 0x12, 0x4b,
+	0x6c, 0xba, 0xab, 0x13, 0x60, 0x0b, 0x65, 0xdc, 0x17, 0xa7, 0x6c, 0x95, 0x59, 0xaa, 0x7a, 0xe8,
+	0x4b, 0x16, 0x12, 0xe8, 0x3b, 0x90, 0x43, 0xcf, 0x29, 0xe5, 0xe6, 0xe6, 0x69, 0x78, 0xb9, 0x0e,
+	0xb9, 0x43, 0x5f, 0xea, 0xa2, 0x71, 0xb9, 0x17, 0xca, 0xc9, 0x31, 0xf3, 0xac, 0x0b, 0xbc, 0xeb,
+	0xbb, 0x83, 0xbe, 0x67, 0xb4, 0xe4, 0x58, 0x64, 0x69, 0x6c, 0x67, 0xa8, 0x30, 0x8c, 0x99, 0x99,
+	0xe7, 0xca, 0xdf, 0x09, 0xe4, 0xf7, 0x51, 0xd9, 0x8e, 0xad, 0x6c, 0xba, 0x01, 0x45, 0x07, 0x65,
+	0x57, 0xf0, 0x40, 0x71, 0xdf, 0x8b, 0x42, 0xcc, 0x0e, 0xd1, 0x7b, 0x70, 0xab, 0x6f, 0x8b, 0x53,
+	0xc7, 0x7f, 0xea, 0xb5, 0xb3, 0xd0, 0xf0, 0x15, 0x7b, 0x39, 0x9e, 0xdb, 0xcb, 0x50, 0xca, 0x90,
+	0xc7, 0x4f, 0xed, 0x7e, 0xe0, 0xa2, 0x2c, 0xe5, 0x36, 0x72, 0x3a, 0x69, 0xb1, 0x4d, 0x5f, 0x05,
+	0x70, 0x30, 0x10, 0xd8, 0xb5, 0x15, 0x3a, 0xe6, 0x9d, 0xcb, 0xb3, 0xcc, 0x08, 0xdd, 0x82, 0x97,
+	0x63, 0x8b, 0xfb, 0x5e, 0xbb, 0x8f, 0x52, 0xda, 0x3d, 0x34, 0x2f, 0x56, 0x81, 0xd1, 0xcc, 0xd4,
+	0x7e, 0x38, 0x53, 0xf9, 0x23, 0x01, 0x38, 0x96, 0x28, 0x1e, 0xf8, 0xde, 0x13, 0xde, 0x2b, 0x17,
+	0x60, 0x85, 0xe1, 0x8f, 0x06, 0x28, 0x55, 0xf9, 0x19, 0x81, 0x3c, 0x43, 0x19, 0xf8, 0x9e, 0x44,
+	0x7a, 0xa8, 0x33, 0xa4, 0x11, 0x51, 0xb9, 0xee, 0x4e, 0xcc, 0x7a, 0xba, 0x58, 0x35, 0x66, 0x57,
+	0x43, 0xbb, 0xee, 0x29, 0x31, 0x64, 0xd1, 0x3a, 0xe5, 0x6f, 0x43, 0x31, 0x33, 0x4c, 0x2d, 0xc8,
+	0x9d, 0xe2, 0x30, 0xca, 0xa0, 0x7e, 0xa4, 0xb7, 0x60, 0xe9, 0xcc, 0x76, 0x07, 0x68, 0x52, 0xb5,
+	0xca, 0x42, 0xe3, 0xdd, 0x85, 0x5d, 0x52, 0xf9, 0x0c, 0x56, 0x3f, 0x70, 0xfd, 0x8e, 0xed, 0x8e,
+	0x8a, 0x76, 0x32, 0x9a, 0xdf, 0x82, 0x97, 0x82, 0x41, 0xc7, 0xe5, 0xdd, 0x36, 0xf7, 0x7a, 0x28,
+	0x55, 0x7b, 0x20, 0x78, 0xe4, 0x60, 0x3d, 0x9c, 0x68, 0x98, 0xf1, 0x63, 0xc1, 0xf5, 0xeb, 0x1f,
+	0x08, 0x7e, 0x66, 0x2b, 0xcc, 0x82, 0xc3, 0x4d, 0xb2, 0xa2, 0x99, 0x04, 0x5d, 0xf9, 0x17, 0x81,
+	0xc5, 0x86, 0xc7, 0x55, 0x79, 0x27, 0xf1, 0x4c, 0x37, 0xc1, 0x12, 0x03, 0xcf, 0x43, 0xd1, 0xee,
+	0x08, 0xff, 0x14, 0x45, 0x9b, 0x3b, 0xc6, 0xd9, 0x4d, 0xb6, 0x16, 0x8e, 0xdf, 0x37, 0xc3, 0x0d,
+	0xa7, 0xfc, 0xdb, 0x6c, 0x62, 0xbf, 0x0f, 0xaf, 0xc9, 0x41, 0x27, 0xd9, 0x7c, 0xd9, 0x16, 0xd8,
+	0xe3, 0x52, 0xa1, 0x40, 0xa7, 0xad, 0xfc, 0x1e, 0xaa, 0x13, 0x14, 0x66, 0x9d, 0x3c, 0xfb, 0xea,
+	0x39, 0x20, 0x4b, 0x70, 0xad, 0x08, 0x46, 0xeb, 0x50, 0x74, 0x92, 0x23, 0x5e, 0x96, 0x16, 0xcc,
+	0x4e, 0xbd, 0x3e, 0xc7, 0x75, 0xc0, 0xb2, 0xbc, 0xca, 0x4f, 0x09, 0x80, 0x8e, 0xee, 0xa8, 0x7b,
+	0x82, 0x7d, 0x3b, 0x9b, 0x5d, 0x9e, 0x11, 0x7e, 0x1b, 0x96, 0xa5, 0x01, 0x18, 0x75, 0xab, 0x2c,
+	0xb2, 0xae, 0x4a, 0xc4, 0x4f, 0x08, 0x14, 0x6b, 0x5d, 0x1d, 0xa9, 0x3e, 0xb8, 0x64, 0x56, 0x45,
+	0x2f, 0xa3, 0xe2, 0x16, 0x2c, 0xe9, 0xc3, 0x24, 0x3c, 0x45, 0x0b, 0x2c, 0x34, 0xae, 0x4a, 0xc3,
+	0xef, 0x16, 0x60, 0x2d, 0xd4, 0x50, 0xd7, 0xc5, 0x67, 0x2b, 0x2c, 0xb3, 0x74, 0xc3, 0x5f, 0x01,
+	0xe8, 0xfa, 0x9e, 0xc2, 0x4f, 0x55, 0xbc, 0xd5, 0x05, 0x56, 0x88, 0x46, 0x1a, 0x8e, 0x3e, 0x3b,
+	0xcc, 0xb1, 0x17, 0xd6, 0x90, 0x79, 0xd6, 0x6a, 0xb9, 0x17, 0x0c, 0xc2, 0x43, 0x74, 0x95, 0x85,
+	0x46, 0xf9, 0x73, 0x72, 0x3e, 0xad, 0xfe, 0x40, 0x69, 0x4c, 0x94, 0xd6, 0xd0, 0xba, 0xa2, 0x90,
+	0xe8, 0x01, 0xac, 0x4b, 0xf4, 0x24, 0x57, 0xfc, 0x0c, 0xdb, 0x81, 0xad, 0x4e, 0xc2, 0x23, 0x66,
+	0xfe, 0x8b, 0x7b, 0x2d, 0xa1, 0x6b, 0x53, 0x56, 0xfe, 0x40, 0xe0, 0xcb, 0x61, 0x8e, 0xc2, 0x97,
+	0x71, 0x20, 0xcc, 0xf1, 0x12, 0xd5, 0xce, 0x2b, 0x69, 0xba, 0xe2, 0x7c, 0x90, 0x34, 0x1f, 0xd7,
+	0x59, 0x4f, 0xbf, 0x21, 0x60, 0x85, 0x3a, 0x0f, 0x4c, 0x42, 0x5b, 0xc3, 0x00, 0x67, 0xc9, 0xc3,
+	0x8c, 0x3c, 0x0a, 0x8b, 0x6a, 0x18, 0x60, 0x24, 0xce, 0x3c, 0x5f, 0x95, 0xb4, 0x9f, 0x11, 0x58,
+	0x6f, 0x09, 0xde, 0xeb, 0xa1, 0x78, 0x84, 0xc3, 0x17, 0x57, 0xee, 0xbf, 0x24, 0x50, 0x8e, 0x74,
+	0x8c, 0xdb, 0xcb, 0x17, 0x73, 0x0e, 0xfc, 0x9e, 0xc0, 0xed, 0x7d, 0x3b, 0x38, 0xb4, 0x87, 0xae,
+	0x6f, 0x3b, 0x2d, 0x3f, 0x4d, 0x54, 0xf9, 0xf5, 0x74, 0xf7, 0x4a, 0xb0, 0x12, 0x84, 0x88, 0x48,
+	0x45, 0x6c, 0x96, 0xbb, 0x19, 0xa9, 0xa3, 0x77, 0xcc, 0x15, 0x89, 0xfc, 0x2b, 0x89, 0x0f, 0x8a,
+	0xb8, 0x33, 0x98, 0x55, 0x5a, 0xbf, 0xc8, 0xbe, 0xf3, 0xdf, 0x85, 0x7c, 0x3f, 0xe2, 0x19, 0x50,
+	0x71, 0xfb, 0xb5, 0x89, 0x12, 0x62, 0x07, 0x2c, 0xa1, 0x5c, 0x55, 0x10, 0x7f, 0x49, 0xcb, 0x30,
+	0x89, 0xe2, 0x2b, 0x69, 0x14, 0x23, 0xc9, 0xfb, 0x22, 0xc6, 0xf0, 0x8c, 0xc0, 0x4b, 0x51, 0x0c,
+	0x99, 0xd7, 0x7c, 0x6a, 0x14, 0xd7, 0xf4, 0x92, 0xff, 0x99, 0x00, 0x7d, 0x20, 0xd0, 0x56, 0x78,
+	0x94, 0xb9, 0xc5, 0xcb, 0xdf, 0x9b, 0xfb, 0x3e, 0x49, 0xee, 0x8e, 0x85, 0xec, 0xdd, 0x71, 0xe1,
+	0x70, 0x90, 0xca, 0x56, 0xb1, 0xfc, 0xd0, 0xb8, 0x2a, 0xfd, 0xff, 0x20, 0x60, 0x31, 0xb4, 0x9d,
+	0x73, 0xea, 0x3f, 0x9c, 0x5b, 0xfd, 0x9b, 0xb0, 0x9e, 0xed, 0x5e, 0x34, 0x26, 0xbc, 0x18, 0xd7,
+	0xb2, 0xc3, 0x0d, 0xe7, 0xfa, 0x02, 0xfa, 0x0f, 0x01, 0x7a, 0x1c, 0x38, 0x17, 0x37, 0xa4, 0x77,
+	0xe5, 0x21, 0x4d, 0xb8, 0xf5, 0xaf, 0x2d, 0xd0, 0xcf, 0x09, 0xd0, 0x3d, 0x74, 0x51, 0xe1, 0xff,
+	0x7b, 0xef, 0x3e, 0xcc, 0x84, 0x74, 0x41, 0x3c, 0x79, 0x3e, 0xf1, 0x6f, 0x3d, 0x85, 0x42, 0xf2,
+	0x4b, 0x5d, 0xff, 0xa2, 0x3e, 0x6e, 0x3e, 0x6a, 0x1e, 0x7c, 0xd4, 0xb4, 0x6e, 0xd0, 0x9b, 0x50,
+	0x60, 0xf5, 0x16, 0xfb, 0x61, 0xed, 0xfe, 0xe3, 0xba, 0x45, 0xa8, 0x05, 0xab, 0xac, 0xd6, 0xaa,
+	0xb7, 0x1f, 0x37, 0xf6, 0x1b, 0xad, 0xfa, 0x9e, 0xb5, 0xa0, 0x01, 0x87, 0x75, 0xb6, 0x5f, 0x6b,
+	0xd6, 0x9b, 0x2d, 0x2b, 0xa7, 0x01, 0xc7, 0xcd, 0xda, 0x71, 0xeb, 0xe1, 0x01, 0x6b, 0x7c, 0x5c,
+	0xdf, 0xb3, 0x16, 0x35, 0xa0, 0x79, 0xd0, 0x6a, 0xbf, 0x7f, 0x70, 0xdc, 0xdc, 0xb3, 0x96, 0xe8,
+	0x2a, 0xe4, 0x1f, 0x1c, 0x34, 0xdf, 0x7f, 0xdc, 0x78, 0xd0, 0xb2, 0x96, 0xb7, 0xff, 0xb6, 0x0e,
+	0xf9, 0xc3, 0x48, 0x20, 0x3d, 0x0e, 0xdb, 0x7d, 0xfa, 0xb5, 0x89, 0xfa, 0xf5, 0x74, 0x35, 0xbe,
+	0x1e, 0xdf, 0x98, 0x05, 0x8b, 0x72, 0xd4, 0xcb, 0xf6, 0xd9, 0xf4, 0xed, 0xa9, 0xac, 0x10, 0x94,
+	0xb8, 0xb8, 0x33, 0x1f, 0x38, 0x72, 0xf4, 0xc9, 0xb9, 0x5e, 0x9a, 0x4e, 0x26, 0x67, 0x50, 0x89,
+	0xab, 0xbb, 0x73, 0xa2, 0x23, 0x5f, 0xf2, 0x62, 0xcf, 0x4c, 0xb7, 0x66, 0x2c, 0x10, 0x03, 0x13,
+	0x8f, 0x5f, 0x9f, 0x9f, 0x10, 0x39, 0xfd, 0xd5, 0xb4, 0x2e, 0x94, 0xbe, 0x3b, 0x63, 0xbd, 0x31,
+	0x9c, 0x44, 0xcb, 0x7b, 0xcf, 0xc5, 0x8d, 0x64, 0x0d, 0x47, 0x7b, 0x4e, 0x7a, 0x6f, 0xc6, 0x82,
+	0x29, 0x34, 0xd1, 0xb0, 0x7d, 0x19, 0xca, 0xc5, 0x6d, 0x48, 0xbe, 0x55, 0xcc, 0xda, 0x86, 0x18,
+	0x38, 0xf7, 0x36, 0x64, 0x08, 0x91, 0xd3, 0xb3, 0x91, 0x46, 0x96, 0x4e, 0x5e, 0xe4, 0x02, 0x32,
+	0x71, 0x7b, 0xef, 0x12, 0x8c, 0xc8, 0xef, 0xb3, 0xa9, 0x9d, 0x2b, 0x7d, 0x6f, 0xd6, 0x8a, 0xd3,
+	0x0a, 0xe0, 0x3b, 0xcf, 0x47, 0x8e, 0x94, 0xfd, 0x7c, 0x62, 0xfb, 0x4a, 0xbf, 0x35, 0xb9, 0x3f,
+	0x1a, 0x4b, 0x48, 0x14, 0xed, 0x5e, 0x9e, 0x18, 0xa9, 0xf9, 0xf1, 0x98, 0xee, 0x88, 0x6e, 0xcf,
+	0x0a, 0x70, 0x4c, 0x45, 0xee, 0x5c, 0x8a, 0x33, 0x52, 0x1d, 0x49, 0x4d, 0xce, 0xac, 0x8e, 0x91,
+	0xa2, 0xbc, 0x77, 0x09, 0x46, 0xe4, 0xf7, 0xb3, 0x71, 0x9d, 0x17, 0x9d, 0x1c, 0xc2, 0x28, 0x38,
+	0xf1, 0xfe, 0xce, 0xe5, 0x48, 0xe9, 0x31, 0x70, 0xb1, 0x75, 0x9a, 0x72, 0x0c, 0x5c, 0x84, 0xce,
+	0x71, 0x0c, 0x8c, 0xa1, 0xa4, 0xb1, 0x8f, 0x36, 0x39, 0x53, 0x62, 0x1f, 0x05, 0xcf, 0x11, 0xfb,
+	0x58, 0x52, 0x2a, 0x60, 0xb4, 0xf9, 0x98, 0x22, 0x60, 0x14, 0x3c, 0x87, 0x80, 0xb1, 0xa4, 0x50,
+	0xc0, 0xf6, 0xbf, 0x09, 0x2c, 0x33, 0xf3, 0x01, 0x4e, 0xdf, 0xb7, 0xe9, 0xd7, 0xc9, 0x29, 0xf7,
+	0xed, 0xb9, 0x4f, 0x98, 0xb3, 0xee, 0xdb, 0x31, 0xdf, 0x3b, 0x69, 0xff, 0xfc, 0x07, 0x4a, 0x3a,
+	0xf9, 0x0a, 0xcd, 0xc2, 0x12, 0x67, 0xd5, 0x79, 0xe1, 0xa1, 0xbb, 0xfb, 0xdf, 0xf8, 0x78, 0xa7,
+	0xc7, 0xd5, 0xc9, 0xa0, 0x53, 0xed, 0xfa, 0xfd, 0xad, 0x0c, 0xf7, 0xae, 0x2f, 0x7a, 0x5b, 0x81,
+	0x3b, 0xe8, 0x71, 0xef, 0xae, 0x74, 0x4e, 0xb7, 0x64, 0x27, 0xfc, 0xd7, 0xfc, 0x89, 0xa9, 0xb3,
+	0x6c, 0xfe, 0xdb, 0xf9, 0xdf, 0x00, 0xbf, 0x3a, 0xed, 0xc8, 0x7b, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ProviderClient is the client API for Provider service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProviderClient interface {
+	Init(ctx context.Context, in *Init_Request, opts ...grpc.CallOption) (*Init_Response, error)
+	InitSchema(ctx context.Context, in *InitSchema_Request, opts ...grpc.CallOption) (*InitSchema_Response, error)
+	ActionNames(ctx context.Context, in *ActionNames_Request, opts ...grpc.CallOption) (*ActionNames_Response, error)
+	ActionEvaluate(ctx context.Context, in *ActionEvaluate_Request, opts ...grpc.CallOption) (*ActionEvaluate_Response, error)
+	ActionConfigurationSchema(ctx context.Context, in *ActionConfigurationSchema_Request, opts ...grpc.CallOption) (*ActionConfigurationSchema_Response, error)
+	ActionOutputType(ctx context.Context, in *ActionOutputType_Request, opts ...grpc.CallOption) (*ActionOutputType_Response, error)
+	ActionMetadata(ctx context.Context, in *ActionMetadata_Request, opts ...grpc.CallOption) (*ActionMetadata_Response, error)
+	TriggerKeyNames(ctx context.Context, in *TriggerKeyNames_Request, opts ...grpc.CallOption) (*TriggerKeyNames_Response, error)
+	TriggerConfigurationSchema(ctx context.Context, in *TriggerConfigurationSchema_Request, opts ...grpc.CallOption) (*TriggerConfigurationSchema_Response, error)
+	MapPayloadToTriggerKey(ctx context.Context, in *MapPayloadToTriggerKey_Request, opts ...grpc.CallOption) (*MapPayloadToTriggerKey_Response, error)
+	TriggerOutputType(ctx context.Context, in *TriggerOutputType_Request, opts ...grpc.CallOption) (*TriggerOutputType_Response, error)
+	TriggerMetadata(ctx context.Context, in *TriggerMetadata_Request, opts ...grpc.CallOption) (*TriggerMetadata_Response, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscription_Request, opts ...grpc.CallOption) (*CreateSubscription_Response, error)
+	ReadSubscription(ctx context.Context, in *ReadSubscription_Request, opts ...grpc.CallOption) (*ReadSubscription_Response, error)
+	UpdateSubscription(ctx context.Context, in *UpdateSubscription_Request, opts ...grpc.CallOption) (*UpdateSubscription_Response, error)
+	DeleteSubscription(ctx context.Context, in *DeleteSubscription_Request, opts ...grpc.CallOption) (*DeleteSubscription_Response, error)
+}
+
+type providerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProviderClient(cc grpc.ClientConnInterface) ProviderClient {
+	return &providerClient{cc}
+}
+
+func (c *providerClient) Init(ctx context.Context, in *Init_Request, opts ...grpc.CallOption) (*Init_Response, error) {
+	out := new(Init_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) InitSchema(ctx context.Context, in *InitSchema_Request, opts ...grpc.CallOption) (*InitSchema_Response, error) {
+	out := new(InitSchema_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/InitSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ActionNames(ctx context.Context, in *ActionNames_Request, opts ...grpc.CallOption) (*ActionNames_Response, error) {
+	out := new(ActionNames_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/ActionNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ActionEvaluate(ctx context.Context, in *ActionEvaluate_Request, opts ...grpc.CallOption) (*ActionEvaluate_Response, error) {
+	out := new(ActionEvaluate_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/ActionEvaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ActionConfigurationSchema(ctx context.Context, in *ActionConfigurationSchema_Request, opts ...grpc.CallOption) (*ActionConfigurationSchema_Response, error) {
+	out := new(ActionConfigurationSchema_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/ActionConfigurationSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ActionOutputType(ctx context.Context, in *ActionOutputType_Request, opts ...grpc.CallOption) (*ActionOutputType_Response, error) {
+	out := new(ActionOutputType_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/ActionOutputType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ActionMetadata(ctx context.Context, in *ActionMetadata_Request, opts ...grpc.CallOption) (*ActionMetadata_Response, error) {
+	out := new(ActionMetadata_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/ActionMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) TriggerKeyNames(ctx context.Context, in *TriggerKeyNames_Request, opts ...grpc.CallOption) (*TriggerKeyNames_Response, error) {
+	out := new(TriggerKeyNames_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/TriggerKeyNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) TriggerConfigurationSchema(ctx context.Context, in *TriggerConfigurationSchema_Request, opts ...grpc.CallOption) (*TriggerConfigurationSchema_Response, error) {
+	out := new(TriggerConfigurationSchema_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/TriggerConfigurationSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) MapPayloadToTriggerKey(ctx context.Context, in *MapPayloadToTriggerKey_Request, opts ...grpc.CallOption) (*MapPayloadToTriggerKey_Response, error) {
+	out := new(MapPayloadToTriggerKey_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/MapPayloadToTriggerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) TriggerOutputType(ctx context.Context, in *TriggerOutputType_Request, opts ...grpc.CallOption) (*TriggerOutputType_Response, error) {
+	out := new(TriggerOutputType_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/TriggerOutputType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) TriggerMetadata(ctx context.Context, in *TriggerMetadata_Request, opts ...grpc.CallOption) (*TriggerMetadata_Response, error) {
+	out := new(TriggerMetadata_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/TriggerMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) CreateSubscription(ctx context.Context, in *CreateSubscription_Request, opts ...grpc.CallOption) (*CreateSubscription_Response, error) {
+	out := new(CreateSubscription_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/CreateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ReadSubscription(ctx context.Context, in *ReadSubscription_Request, opts ...grpc.CallOption) (*ReadSubscription_Response, error) {
+	out := new(ReadSubscription_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/ReadSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) UpdateSubscription(ctx context.Context, in *UpdateSubscription_Request, opts ...grpc.CallOption) (*UpdateSubscription_Response, error) {
+	out := new(UpdateSubscription_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/UpdateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) DeleteSubscription(ctx context.Context, in *DeleteSubscription_Request, opts ...grpc.CallOption) (*DeleteSubscription_Response, error) {
+	out := new(DeleteSubscription_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Provider/DeleteSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProviderServer is the server API for Provider service.
+type ProviderServer interface {
+	Init(context.Context, *Init_Request) (*Init_Response, error)
+	InitSchema(context.Context, *InitSchema_Request) (*InitSchema_Response, error)
+	ActionNames(context.Context, *ActionNames_Request) (*ActionNames_Response, error)
+	ActionEvaluate(context.Context, *ActionEvaluate_Request) (*ActionEvaluate_Response, error)
+	ActionConfigurationSchema(context.Context, *ActionConfigurationSchema_Request) (*ActionConfigurationSchema_Response, error)
+	ActionOutputType(context.Context, *ActionOutputType_Request) (*ActionOutputType_Response, error)
+	ActionMetadata(context.Context, *ActionMetadata_Request) (*ActionMetadata_Response, error)
+	TriggerKeyNames(context.Context, *TriggerKeyNames_Request) (*TriggerKeyNames_Response, error)
+	TriggerConfigurationSchema(context.Context, *TriggerConfigurationSchema_Request) (*TriggerConfigurationSchema_Response, error)
+	MapPayloadToTriggerKey(context.Context, *MapPayloadToTriggerKey_Request) (*MapPayloadToTriggerKey_Response, error)
+	TriggerOutputType(context.Context, *TriggerOutputType_Request) (*TriggerOutputType_Response, error)
+	TriggerMetadata(context.Context, *TriggerMetadata_Request) (*TriggerMetadata_Response, error)
+	CreateSubscription(context.Context, *CreateSubscription_Request) (*CreateSubscription_Response, error)
+	ReadSubscription(context.Context, *ReadSubscription_Request) (*ReadSubscription_Response, error)
+	UpdateSubscription(context.Context, *UpdateSubscription_Request) (*UpdateSubscription_Response, error)
+	DeleteSubscription(context.Context, *DeleteSubscription_Request) (*DeleteSubscription_Response, error)
+}
+
+// UnimplementedProviderServer can be embedded to have forward compatible implementations.
+type UnimplementedProviderServer struct {
+}
+
+func (*UnimplementedProviderServer) Init(ctx context.Context, req *Init_Request) (*Init_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (*UnimplementedProviderServer) InitSchema(ctx context.Context, req *InitSchema_Request) (*InitSchema_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitSchema not implemented")
+}
+func (*UnimplementedProviderServer) ActionNames(ctx context.Context, req *ActionNames_Request) (*ActionNames_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionNames not implemented")
+}
+func (*UnimplementedProviderServer) ActionEvaluate(ctx context.Context, req *ActionEvaluate_Request) (*ActionEvaluate_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionEvaluate not implemented")
+}
+func (*UnimplementedProviderServer) ActionConfigurationSchema(ctx context.Context, req *ActionConfigurationSchema_Request) (*ActionConfigurationSchema_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionConfigurationSchema not implemented")
+}
+func (*UnimplementedProviderServer) ActionOutputType(ctx context.Context, req *ActionOutputType_Request) (*ActionOutputType_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionOutputType not implemented")
+}
+func (*UnimplementedProviderServer) ActionMetadata(ctx context.Context, req *ActionMetadata_Request) (*ActionMetadata_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionMetadata not implemented")
+}
+func (*UnimplementedProviderServer) TriggerKeyNames(ctx context.Context, req *TriggerKeyNames_Request) (*TriggerKeyNames_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerKeyNames not implemented")
+}
+func (*UnimplementedProviderServer) TriggerConfigurationSchema(ctx context.Context, req *TriggerConfigurationSchema_Request) (*TriggerConfigurationSchema_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerConfigurationSchema not implemented")
+}
+func (*UnimplementedProviderServer) MapPayloadToTriggerKey(ctx context.Context, req *MapPayloadToTriggerKey_Request) (*MapPayloadToTriggerKey_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MapPayloadToTriggerKey not implemented")
+}
+func (*UnimplementedProviderServer) TriggerOutputType(ctx context.Context, req *TriggerOutputType_Request) (*TriggerOutputType_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerOutputType not implemented")
+}
+func (*UnimplementedProviderServer) TriggerMetadata(ctx context.Context, req *TriggerMetadata_Request) (*TriggerMetadata_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerMetadata not implemented")
+}
+func (*UnimplementedProviderServer) CreateSubscription(ctx context.Context, req *CreateSubscription_Request) (*CreateSubscription_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (*UnimplementedProviderServer) ReadSubscription(ctx context.Context, req *ReadSubscription_Request) (*ReadSubscription_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSubscription not implemented")
+}
+func (*UnimplementedProviderServer) UpdateSubscription(ctx context.Context, req *UpdateSubscription_Request) (*UpdateSubscription_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+func (*UnimplementedProviderServer) DeleteSubscription(ctx context.Context, req *DeleteSubscription_Request) (*DeleteSubscription_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+
+func RegisterProviderServer(s *grpc.Server, srv ProviderServer) {
+	s.RegisterService(&_Provider_serviceDesc, srv)
+}
+
+func _Provider_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Init_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).Init(ctx, req.(*Init_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_InitSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitSchema_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).InitSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/InitSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).InitSchema(ctx, req.(*InitSchema_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ActionNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionNames_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ActionNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/ActionNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ActionNames(ctx, req.(*ActionNames_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ActionEvaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionEvaluate_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ActionEvaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/ActionEvaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ActionEvaluate(ctx, req.(*ActionEvaluate_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ActionConfigurationSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionConfigurationSchema_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ActionConfigurationSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/ActionConfigurationSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ActionConfigurationSchema(ctx, req.(*ActionConfigurationSchema_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ActionOutputType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionOutputType_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ActionOutputType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/ActionOutputType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ActionOutputType(ctx, req.(*ActionOutputType_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ActionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionMetadata_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ActionMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/ActionMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ActionMetadata(ctx, req.(*ActionMetadata_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_TriggerKeyNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerKeyNames_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).TriggerKeyNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/TriggerKeyNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).TriggerKeyNames(ctx, req.(*TriggerKeyNames_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_TriggerConfigurationSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerConfigurationSchema_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).TriggerConfigurationSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/TriggerConfigurationSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).TriggerConfigurationSchema(ctx, req.(*TriggerConfigurationSchema_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_MapPayloadToTriggerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapPayloadToTriggerKey_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).MapPayloadToTriggerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/MapPayloadToTriggerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).MapPayloadToTriggerKey(ctx, req.(*MapPayloadToTriggerKey_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_TriggerOutputType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerOutputType_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).TriggerOutputType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/TriggerOutputType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).TriggerOutputType(ctx, req.(*TriggerOutputType_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_TriggerMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerMetadata_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).TriggerMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/TriggerMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).TriggerMetadata(ctx, req.(*TriggerMetadata_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscription_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/CreateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CreateSubscription(ctx, req.(*CreateSubscription_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ReadSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSubscription_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ReadSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/ReadSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ReadSubscription(ctx, req.(*ReadSubscription_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubscription_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/UpdateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).UpdateSubscription(ctx, req.(*UpdateSubscription_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscription_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Provider/DeleteSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).DeleteSubscription(ctx, req.(*DeleteSubscription_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Provider_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "switchboard.provider.v3.Provider",
+	HandlerType: (*ProviderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _Provider_Init_Handler,
+		},
+		{
+			MethodName: "InitSchema",
+			Handler:    _Provider_InitSchema_Handler,
+		},
+		{
+			MethodName: "ActionNames",
+			Handler:    _Provider_ActionNames_Handler,
+		},
+		{
+			MethodName: "ActionEvaluate",
+			Handler:    _Provider_ActionEvaluate_Handler,
+		},
+		{
+			MethodName: "ActionConfigurationSchema",
+			Handler:    _Provider_ActionConfigurationSchema_Handler,
+		},
+		{
+			MethodName: "ActionOutputType",
+			Handler:    _Provider_ActionOutputType_Handler,
+		},
+		{
+			MethodName: "ActionMetadata",
+			Handler:    _Provider_ActionMetadata_Handler,
+		},
+		{
+			MethodName: "TriggerKeyNames",
+			Handler:    _Provider_TriggerKeyNames_Handler,
+		},
+		{
+			MethodName: "TriggerConfigurationSchema",
+			Handler:    _Provider_TriggerConfigurationSchema_Handler,
+		},
+		{
+			MethodName: "MapPayloadToTriggerKey",
+			Handler:    _Provider_MapPayloadToTriggerKey_Handler,
+		},
+		{
+			MethodName: "TriggerOutputType",
+			Handler:    _Provider_TriggerOutputType_Handler,
+		},
+		{
+			MethodName: "TriggerMetadata",
+			Handler:    _Provider_TriggerMetadata_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _Provider_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "ReadSubscription",
+			Handler:    _Provider_ReadSubscription_Handler,
+		},
+		{
+			MethodName: "UpdateSubscription",
+			Handler:    _Provider_UpdateSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _Provider_DeleteSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provider.proto",
+}
+
+// RunnerClient is the client API for Runner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RunnerClient interface {
+	UserConfig(ctx context.Context, in *UserConfig_Request, opts ...grpc.CallOption) (*UserConfig_Response, error)
+	GlobalConfig(ctx context.Context, in *GlobalConfig_Request, opts ...grpc.CallOption) (*GlobalConfig_Response, error)
+}
+
+type runnerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRunnerClient(cc grpc.ClientConnInterface) RunnerClient {
+	return &runnerClient{cc}
+}
+
+func (c *runnerClient) UserConfig(ctx context.Context, in *UserConfig_Request, opts ...grpc.CallOption) (*UserConfig_Response, error) {
+	out := new(UserConfig_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Runner/UserConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GlobalConfig(ctx context.Context, in *GlobalConfig_Request, opts ...grpc.CallOption) (*GlobalConfig_Response, error) {
+	out := new(GlobalConfig_Response)
+	err := c.cc.Invoke(ctx, "/switchboard.provider.v3.Runner/GlobalConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RunnerServer is the server API for Runner service.
+type RunnerServer interface {
+	UserConfig(context.Context, *UserConfig_Request) (*UserConfig_Response, error)
+	GlobalConfig(context.Context, *GlobalConfig_Request) (*GlobalConfig_Response, error)
+}
+
+// UnimplementedRunnerServer can be embedded to have forward compatible implementations.
+type UnimplementedRunnerServer struct {
+}
+
+func (*UnimplementedRunnerServer) UserConfig(ctx context.Context, req *UserConfig_Request) (*UserConfig_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserConfig not implemented")
+}
+func (*UnimplementedRunnerServer) GlobalConfig(ctx context.Context, req *GlobalConfig_Request) (*GlobalConfig_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalConfig not implemented")
+}
+
+func RegisterRunnerServer(s *grpc.Server, srv RunnerServer) {
+	s.RegisterService(&_Runner_serviceDesc, srv)
+}
+
+func _Runner_UserConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).UserConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Runner/UserConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).UserConfig(ctx, req.(*UserConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GlobalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GlobalConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GlobalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/switchboard.provider.v3.Runner/GlobalConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GlobalConfig(ctx, req.(*GlobalConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Runner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "switchboard.provider.v3.Runner",
+	HandlerType: (*RunnerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UserConfig",
+			Handler:    _Runner_UserConfig_Handler,
+		},
+		{
+			MethodName: "GlobalConfig",
+			Handler:    _Runner_GlobalConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provider.proto",
+}
@@ -2,13 +2,17 @@ package sbsdk
 
 import (
 	"encoding/gob"
+	"fmt"
+	"sort"
+
 	"github.com/hashicorp/hcl/v2/hcldec"
 	"github.com/zclconf/go-cty/cty"
-	"github.com/zclconf/go-cty/cty/json"
 )
 
 func init() {
-	gob.Register(&ObjectSchema{})
+	//ObjectSchema used to be a map, which runners of protocol version 2 still decode under the
+	//default name of ObjectSchema, so that name belongs to ObjectSchemaV2
+	gob.RegisterName("*github.com/switchboard-org/plugin-sdk/sbsdk.ObjectSchemaV3", &ObjectSchema{})
 	gob.Register(&AttrSchema{})
 	gob.Register(&BlockSchema{})
 }
@@ -28,37 +32,169 @@ type Schema interface {
 // ObjectSchema is primarily used as root of all schemas in switchboard. This enforces a key/value
 // style of Config for anything using this. Many Provider interface methods will
 // return this to enforce a certain style of how providers expect user Config data to look.
-type ObjectSchema map[string]Schema
+type ObjectSchema struct {
+	//Attributes are the schemas of the object's attributes and blocks, keyed by their name
+	Attributes map[string]Schema
+	//Constraints are rules about which of the Attributes may be set together. They are
+	//enforced by ValidateConfig, and can be added with AddConstraints.
+	Constraints []Constraint
+}
 
 func (s *ObjectSchema) Decode() hcldec.Spec {
 	outputSpec := hcldec.ObjectSpec{}
-	for k, v := range *s {
+	for k, v := range s.Attributes {
 		outputSpec[k] = v.Decode()
 	}
 	return outputSpec
 }
 
-// BlockSchema maps to block types from the Config. Blocks with labels are not
-// supported, meaning only one block type per provided Config is permitted
+const (
+	BLOCK_MODE_SINGLE = "single"
+	BLOCK_MODE_LIST   = "list"
+	BLOCK_MODE_SET    = "set"
+	BLOCK_MODE_MAP    = "map"
+)
+
+// BlockSchema maps to block types from the Config. By default at most one block of the type is
+// permitted, and Mode allows repeated blocks to be decoded into a list, set or map instead.
 type BlockSchema struct {
+	Metadata
 	Name     string
 	Required bool
 	Nested   Schema
+	//Mode is one of the BLOCK_MODE constants. An empty Mode is the same as BLOCK_MODE_SINGLE.
+	Mode string
+	//Labels names the labels each block must have, such as `header "X-Foo" { ... }`. In BLOCK_MODE_MAP
+	//the labels are the keys of the decoded map, one level of map per label. In every other mode they
+	//are decoded as string attributes of the block, alongside the attributes of Nested, which must
+	//then be an ObjectSchema.
+	Labels []string
+	//MinItems and MaxItems bound the number of blocks in BLOCK_MODE_LIST and BLOCK_MODE_SET.
+	//Zero means no bound, and a Required block must appear at least once, in any mode.
+	MinItems int
+	MaxItems int
 }
 
+// Decode returns the spec of the block. Required blocks in BLOCK_MODE_LIST and BLOCK_MODE_SET
+// must appear at least once, while hcldec can't require blocks in BLOCK_MODE_MAP, so
+// ValidateConfig does. A block in BLOCK_MODE_MAP must have labels, as CheckSchema reports.
 func (b *BlockSchema) Decode() hcldec.Spec {
-	return &hcldec.BlockSpec{
-		TypeName: b.Name,
-		Nested:   b.Nested.Decode(),
-		Required: b.Required,
+	nested := b.Nested.Decode()
+	if b.Mode == BLOCK_MODE_MAP {
+		return &hcldec.BlockMapSpec{
+			TypeName:   b.Name,
+			LabelNames: b.Labels,
+			Nested:     nested,
+		}
+	}
+	if objectSpec, ok := nested.(hcldec.ObjectSpec); ok && len(b.Labels) > 0 {
+		withLabels := make(hcldec.ObjectSpec, len(objectSpec)+len(b.Labels))
+		for k, v := range objectSpec {
+			withLabels[k] = v
+		}
+		for i, label := range b.Labels {
+			withLabels[label] = &hcldec.BlockLabelSpec{Index: i, Name: label}
+		}
+		nested = withLabels
+	}
+	minItems := b.MinItems
+	if b.Required && minItems == 0 {
+		minItems = 1
+	}
+	switch b.Mode {
+	case BLOCK_MODE_LIST:
+		return &hcldec.BlockListSpec{
+			TypeName: b.Name,
+			Nested:   nested,
+			MinItems: minItems,
+			MaxItems: b.MaxItems,
+		}
+	case BLOCK_MODE_SET:
+		return &hcldec.BlockSetSpec{
+			TypeName: b.Name,
+			Nested:   nested,
+			MinItems: minItems,
+			MaxItems: b.MaxItems,
+		}
+	default:
+		return &hcldec.BlockSpec{
+			TypeName: b.Name,
+			Nested:   nested,
+			Required: b.Required,
+		}
+	}
+}
+
+// CheckSchema reports the mistakes in a schema that would otherwise only surface when the runner
+// decodes configuration with it, such as a BLOCK_MODE_MAP block without labels or a validator
+// whose regular expression doesn't compile. Providers built with NewProvider check the schemas
+// they return, and runners should check a schema before calling Decode on it.
+func CheckSchema(schema Schema) Diagnostics {
+	return checkSchema(schema, cty.Path{})
+}
+
+func checkSchema(schema Schema, path cty.Path) Diagnostics {
+	var diags Diagnostics
+	switch s := schema.(type) {
+	case *ObjectSchema:
+		keys := make([]string, 0, len(s.Attributes))
+		for k := range s.Attributes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			diags = append(diags, checkSchema(s.Attributes[k], path.GetAttr(k))...)
+		}
+		for _, constraint := range s.Constraints {
+			diags = append(diags, constraint.check(s, path)...)
+		}
+	case *BlockSchema:
+		switch s.Mode {
+		case "", BLOCK_MODE_SINGLE, BLOCK_MODE_LIST, BLOCK_MODE_SET:
+		case BLOCK_MODE_MAP:
+			if len(s.Labels) == 0 {
+				diags = append(diags, invalidSchema(path, fmt.Sprintf("Block %q is in map mode, which requires at least one label.", s.Name)))
+			}
+		default:
+			diags = append(diags, invalidSchema(path, fmt.Sprintf("Block %q has unknown mode %q.", s.Name, s.Mode)))
+		}
+		if s.MinItems < 0 || s.MaxItems < 0 || (s.MaxItems > 0 && s.MinItems > s.MaxItems) {
+			diags = append(diags, invalidSchema(path, fmt.Sprintf("Block %q has invalid bounds of %d to %d blocks.", s.Name, s.MinItems, s.MaxItems)))
+		}
+		if s.Nested == nil {
+			diags = append(diags, invalidSchema(path, fmt.Sprintf("Block %q has no nested schema.", s.Name)))
+		} else {
+			diags = append(diags, checkSchema(s.Nested, path)...)
+		}
+	case *AttrSchema:
+		for _, validator := range s.Validators {
+			diags = append(diags, validator.check(path)...)
+		}
+	}
+	return diags
+}
+
+func invalidSchema(path cty.Path, detail string) Diagnostic {
+	return Diagnostic{
+		Severity:  DiagError,
+		Summary:   "Invalid schema",
+		Detail:    detail,
+		Attribute: path,
 	}
 }
 
 // AttrSchema represents a key/val coming from the provided data structure
 type AttrSchema struct {
+	Metadata
 	Name     string
 	Required bool
 	Type     Type
+	//Sensitive marks the attribute's value as sensitive when it's decoded, as Type.Sensitive does.
+	//Use it for API keys and other secrets.
+	Sensitive bool
+	//Validators are checked against the attribute's value by ValidateConfig, after it has been
+	//decoded to Type. They're skipped when the value is null or unknown.
+	Validators []Validator
 }
 
 func (b *AttrSchema) Decode() hcldec.Spec {
@@ -69,12 +205,84 @@ func (b *AttrSchema) Decode() hcldec.Spec {
 	}
 }
 
+// UnmarshalVal decodes cty JSON that should conform to schema. Values that don't conform
+// are reported as Diagnostics pointing at the offending attribute.
 func UnmarshalVal(schema Schema, data []byte) (cty.Value, error) {
+	if diags := CheckSchema(schema); diags.HasErrors() {
+		return cty.NilVal, diags
+	}
 	t := hcldec.ImpliedType(schema.Decode())
-	return json.Unmarshal(data, t)
+	val, err := unmarshalValue(data, t, impliedType(schema))
+	if err != nil {
+		return cty.NilVal, valueDiagnostics("Invalid configuration", err)
+	}
+	return val, nil
 }
 
+// MarshalVal encodes val as cty JSON conforming to schema. Values that don't conform
+// are reported as Diagnostics pointing at the offending attribute.
 func MarshalVal(schema Schema, val cty.Value) ([]byte, error) {
 	t := hcldec.ImpliedType(schema.Decode())
-	return json.Marshal(val, t)
+	data, err := marshalValue(val, t, impliedType(schema))
+	if err != nil {
+		return nil, valueDiagnostics("Invalid configuration", err)
+	}
+	return data, nil
+}
+
+// impliedType returns the Type of the values a schema decodes to, so that the defaults declared
+// by attribute types can be applied to them, and the attributes the schema requires checked.
+func impliedType(schema Schema) Type {
+	switch s := schema.(type) {
+	case *ObjectSchema:
+		nested := make(map[string]Type, len(s.Attributes))
+		var optional []string
+		for k, v := range s.Attributes {
+			nested[k] = impliedType(v)
+			switch v := v.(type) {
+			case *AttrSchema:
+				if !v.Required {
+					optional = append(optional, k)
+				}
+			case *BlockSchema:
+				if !v.Required {
+					optional = append(optional, k)
+				}
+			}
+		}
+		return ObjectWithOptionalAttrs(nested, optional...)
+	case *BlockSchema:
+		nested := impliedType(s.Nested)
+		if s.Mode != BLOCK_MODE_MAP && len(s.Labels) > 0 && nested.TypeName == OBJECT_TYPE && nested.NestedValues != nil {
+			withLabels := make(map[string]Type, len(*nested.NestedValues)+len(s.Labels))
+			for k, v := range *nested.NestedValues {
+				withLabels[k] = v
+			}
+			for _, label := range s.Labels {
+				withLabels[label] = String
+			}
+			nested.NestedValues = &withLabels
+		}
+		switch s.Mode {
+		case BLOCK_MODE_LIST:
+			return List(nested)
+		case BLOCK_MODE_SET:
+			return Set(nested)
+		case BLOCK_MODE_MAP:
+			for range s.Labels {
+				nested = Map(nested)
+			}
+			return nested
+		default:
+			return nested
+		}
+	case *AttrSchema:
+		t := s.Type
+		if s.Sensitive {
+			t.Sensitive = true
+		}
+		return t
+	default:
+		return Dynamic
+	}
 }
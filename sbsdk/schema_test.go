@@ -0,0 +1,137 @@
+package sbsdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hcldec"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func decodeConfig(t *testing.T, schema Schema, src string) (cty.Value, hcl.Diagnostics) {
+	t.Helper()
+	file, diags := hclsyntax.ParseConfig([]byte(src), "main.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	return hcldec.Decode(file.Body, schema.Decode(), nil)
+}
+
+func TestBlockModes(t *testing.T) {
+	header := &ObjectSchema{Attributes: map[string]Schema{"value": RequiredAttrSchema("value", String)}}
+	schema := &ObjectSchema{Attributes: map[string]Schema{
+		"header": ListBlockSchema("header", header, 0, 2),
+		"tag":    SetBlockSchema("tag", header, 0, 0),
+		"route":  MapBlockSchema("route", header, "method", "path"),
+		"auth":   OptionalBlockSchema("auth", header),
+	}}
+	if diags := CheckSchema(schema); diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	val, diags := decodeConfig(t, schema, `
+header { value = "a" }
+header { value = "b" }
+tag { value = "x" }
+tag { value = "x" }
+route "GET" "/users" { value = "list" }
+route "POST" "/users" { value = "create" }
+`)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	headers := val.GetAttr("header")
+	if !headers.Type().IsListType() || headers.LengthInt() != 2 || headers.Index(cty.NumberIntVal(1)).GetAttr("value") != cty.StringVal("b") {
+		t.Errorf("got headers %#v", headers)
+	}
+	if tags := val.GetAttr("tag"); !tags.Type().IsSetType() || tags.LengthInt() != 1 {
+		t.Errorf("got tags %#v", tags)
+	}
+	routes := val.GetAttr("route")
+	if got := routes.Index(cty.StringVal("POST")).Index(cty.StringVal("/users")).GetAttr("value"); got != cty.StringVal("create") {
+		t.Errorf("got route %#v", got)
+	}
+	if !val.GetAttr("auth").IsNull() {
+		t.Errorf("got auth %#v, want null", val.GetAttr("auth"))
+	}
+
+	//the decoded value must survive the trip through the wire encoding
+	data, err := MarshalVal(schema, val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := UnmarshalVal(schema, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.RawEquals(val) {
+		t.Errorf("got %#v, want %#v", decoded, val)
+	}
+
+	_, diags = decodeConfig(t, schema, `
+header { value = "a" }
+header { value = "b" }
+header { value = "c" }
+`)
+	if !diags.HasErrors() {
+		t.Error("got no error for too many header blocks")
+	}
+}
+
+func TestBlockLabelsOutsideMapMode(t *testing.T) {
+	schema := &ObjectSchema{Attributes: map[string]Schema{
+		"header": &BlockSchema{
+			Name:   "header",
+			Mode:   BLOCK_MODE_LIST,
+			Labels: []string{"name"},
+			Nested: &ObjectSchema{Attributes: map[string]Schema{"value": RequiredAttrSchema("value", String)}},
+		},
+	}}
+	val, diags := decodeConfig(t, schema, `
+header "X-Foo" { value = "a" }
+header "X-Bar" { value = "b" }
+`)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	want := cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("X-Bar"), "value": cty.StringVal("b")})
+	if got := val.GetAttr("header").Index(cty.NumberIntVal(1)); !got.RawEquals(want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+	_, diags = decodeConfig(t, schema, `header { value = "a" }`)
+	if !diags.HasErrors() {
+		t.Error("got no error for a header block without its label")
+	}
+}
+
+func TestRequiredBlocks(t *testing.T) {
+	nested := &ObjectSchema{Attributes: map[string]Schema{"value": OptionalAttrSchema("value", String)}}
+	for _, mode := range []string{BLOCK_MODE_SINGLE, BLOCK_MODE_LIST, BLOCK_MODE_SET} {
+		schema := &ObjectSchema{Attributes: map[string]Schema{"header": &BlockSchema{Name: "header", Mode: mode, Required: true, Nested: nested}}}
+		if _, diags := decodeConfig(t, schema, ``); !diags.HasErrors() {
+			t.Errorf("%s: got no error for a missing required block", mode)
+		}
+		if _, diags := decodeConfig(t, schema, `header {}`); diags.HasErrors() {
+			t.Errorf("%s: %s", mode, diags)
+		}
+	}
+}
+
+func TestCheckSchema(t *testing.T) {
+	nested := &ObjectSchema{}
+	tests := map[string]Schema{
+		"map mode without labels": &BlockSchema{Name: "route", Mode: BLOCK_MODE_MAP, Nested: nested},
+		"unknown mode":            &BlockSchema{Name: "route", Mode: "tree", Nested: nested},
+		"negative bound":          &BlockSchema{Name: "route", Mode: BLOCK_MODE_LIST, MinItems: -1, Nested: nested},
+		"inverted bounds":         &BlockSchema{Name: "route", Mode: BLOCK_MODE_LIST, MinItems: 3, MaxItems: 2, Nested: nested},
+		"no nested schema":        &BlockSchema{Name: "route"},
+	}
+	for name, block := range tests {
+		diags := CheckSchema(&ObjectSchema{Attributes: map[string]Schema{"outer": &BlockSchema{Name: "outer", Nested: &ObjectSchema{Attributes: map[string]Schema{"route": block}}}}})
+		if !diags.HasErrors() {
+			t.Errorf("%s: got no diagnostics", name)
+			continue
+		}
+		assertDiagnosticPath(t, diags, cty.GetAttrPath("outer").GetAttr("route"))
+	}
+}
@@ -0,0 +1,257 @@
+package sbsdk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// valueMark is the type of the cty marks applied by this package, so that they can't collide
+// with marks applied by other packages.
+type valueMark string
+
+const (
+	//SENSITIVE_MARK is the cty mark carried by values decoded from a sensitive attribute or type
+	SENSITIVE_MARK = valueMark("sensitive")
+	//REDACTED replaces sensitive values in formatted values, paths and diagnostics
+	REDACTED = "(sensitive value)"
+)
+
+// MarkSensitive marks val as sensitive, so that FormatValue and the SDK's diagnostics redact it.
+func MarkSensitive(val cty.Value) cty.Value {
+	return val.Mark(SENSITIVE_MARK)
+}
+
+// IsSensitive reports whether val itself is marked sensitive. Collections may contain
+// sensitive values without being sensitive themselves.
+func IsSensitive(val cty.Value) bool {
+	return val.HasMark(SENSITIVE_MARK)
+}
+
+// FormatValue renders val in hcl syntax for logs and error messages, with every sensitive
+// value, at any depth, replaced by REDACTED.
+func FormatValue(val cty.Value) string {
+	var b strings.Builder
+	formatValue(&b, val)
+	return b.String()
+}
+
+func formatValue(b *strings.Builder, val cty.Value) {
+	if val.HasMark(SENSITIVE_MARK) {
+		b.WriteString(REDACTED)
+		return
+	}
+	val, _ = val.Unmark()
+	switch {
+	case !val.IsKnown():
+		b.WriteString("(unknown)")
+	case val.IsNull():
+		b.WriteString("null")
+	case val.Type() == cty.String:
+		fmt.Fprintf(b, "%q", val.AsString())
+	case val.Type() == cty.Number:
+		b.WriteString(val.AsBigFloat().Text('f', -1))
+	case val.Type() == cty.Bool:
+		fmt.Fprintf(b, "%t", val.True())
+	case val.Type().IsObjectType() || val.Type().IsMapType():
+		elements := val.AsValueMap()
+		keys := make([]string, 0, len(elements))
+		for k := range elements {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(b, "%s = ", k)
+			formatValue(b, elements[k])
+		}
+		b.WriteByte('}')
+	case val.CanIterateElements():
+		b.WriteByte('[')
+		iter := val.ElementIterator()
+		for i := 0; iter.Next(); i++ {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			_, el := iter.Element()
+			formatValue(b, el)
+		}
+		b.WriteByte(']')
+	default:
+		b.WriteString(val.Type().FriendlyName())
+	}
+}
+
+// markSensitive returns val with every part that t declares sensitive marked with SENSITIVE_MARK.
+func markSensitive(val cty.Value, t Type) cty.Value {
+	if t.Sensitive {
+		return val.Mark(SENSITIVE_MARK)
+	}
+	if !t.hasSensitive() || val.IsNull() || !val.IsKnown() {
+		return val
+	}
+	switch t.TypeName {
+	case OBJECT_TYPE:
+		attrs := val.AsValueMap()
+		for name, attrType := range *t.NestedValues {
+			if attr, ok := attrs[name]; ok {
+				attrs[name] = markSensitive(attr, attrType)
+			}
+		}
+		return cty.ObjectVal(attrs)
+	case LIST_TYPE, SET_TYPE, MAP_TYPE:
+		if val.LengthInt() == 0 {
+			return val
+		}
+		elements := make(map[string]cty.Value)
+		var list []cty.Value
+		iter := val.ElementIterator()
+		for iter.Next() {
+			k, v := iter.Element()
+			el := markSensitive(v, *t.InternalType)
+			if t.TypeName == MAP_TYPE {
+				elements[k.AsString()] = el
+			} else {
+				list = append(list, el)
+			}
+		}
+		switch t.TypeName {
+		case MAP_TYPE:
+			return cty.MapVal(elements)
+		case SET_TYPE:
+			return cty.SetVal(list)
+		default:
+			return cty.ListVal(list)
+		}
+	case TUPLE_TYPE:
+		if val.LengthInt() != len(*t.ElementTypes) {
+			return val
+		}
+		elements := make([]cty.Value, 0, len(*t.ElementTypes))
+		for i, elementType := range *t.ElementTypes {
+			elements = append(elements, markSensitive(val.Index(cty.NumberIntVal(int64(i))), elementType))
+		}
+		return cty.TupleVal(elements)
+	default:
+		return val
+	}
+}
+
+// sensitivePaths returns the paths of every value in val that is marked with SENSITIVE_MARK.
+func sensitivePaths(val cty.Value) []cty.Path {
+	_, pvm := val.UnmarkDeepWithPaths()
+	var out []cty.Path
+	for _, pm := range pvm {
+		if _, ok := pm.Marks[SENSITIVE_MARK]; ok {
+			out = append(out, pm.Path)
+		}
+	}
+	return out
+}
+
+// hasSensitive reports whether t or any type nested in it is sensitive.
+func (t *Type) hasSensitive() bool {
+	if t.Sensitive {
+		return true
+	}
+	if t.NestedValues != nil {
+		for _, v := range *t.NestedValues {
+			if v.hasSensitive() {
+				return true
+			}
+		}
+	}
+	if t.InternalType != nil && t.InternalType.hasSensitive() {
+		return true
+	}
+	if t.ElementTypes != nil {
+		for _, v := range *t.ElementTypes {
+			if v.hasSensitive() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// redactPath replaces sensitive index keys in path with REDACTED, so that the path can be
+// formatted or sent over the wire.
+func redactPath(path cty.Path) cty.Path {
+	var out cty.Path
+	for _, step := range path {
+		if s, ok := step.(cty.IndexStep); ok && s.Key.IsMarked() {
+			key := cty.StringVal(REDACTED)
+			if !s.Key.HasMark(SENSITIVE_MARK) {
+				key, _ = s.Key.Unmark()
+			}
+			step = cty.IndexStep{Key: key}
+		}
+		out = append(out, step)
+	}
+	return out
+}
+
+// redactError removes every sensitive string in val from the messages of err, so that a
+// provider can't leak a secret from its input by including it in an error. err is returned
+// unchanged when it contains no sensitive strings.
+func redactError(err error, val cty.Value) error {
+	if err == nil {
+		return nil
+	}
+	secrets := sensitiveStrings(val)
+	if len(secrets) == 0 {
+		return err
+	}
+	redact := func(s string) string {
+		for _, secret := range secrets {
+			s = strings.ReplaceAll(s, secret, REDACTED)
+		}
+		return s
+	}
+	var found bool
+	for _, secret := range secrets {
+		if strings.Contains(err.Error(), secret) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return err
+	}
+	diags := DiagnosticsFromError(err)
+	out := make(Diagnostics, 0, len(diags))
+	for _, diag := range diags {
+		diag.Summary = redact(diag.Summary)
+		diag.Detail = redact(diag.Detail)
+		out = append(out, diag)
+	}
+	return out
+}
+
+// sensitiveStrings returns every non-empty string in val that is marked sensitive, or is nested
+// in a sensitive value, longest first so that no secret is only partly redacted.
+func sensitiveStrings(val cty.Value) []string {
+	var out []string
+	_ = cty.Walk(val, func(_ cty.Path, v cty.Value) (bool, error) {
+		if !v.HasMark(SENSITIVE_MARK) {
+			return true, nil
+		}
+		unmarked, _ := v.UnmarkDeep()
+		_ = cty.Walk(unmarked, func(_ cty.Path, leaf cty.Value) (bool, error) {
+			if leaf.IsKnown() && !leaf.IsNull() && leaf.Type() == cty.String && leaf.AsString() != "" {
+				out = append(out, leaf.AsString())
+			}
+			return true, nil
+		})
+		return false, nil
+	})
+	sort.Slice(out, func(i, j int) bool {
+		return len(out[i]) > len(out[j])
+	})
+	return out
+}
@@ -0,0 +1,129 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// testAction is an Action whose Evaluate is set by each test.
+type testAction struct {
+	schema     ObjectSchema
+	outputType Type
+	evaluate   func(input cty.Value) (cty.Value, error)
+}
+
+func (a *testAction) ConfigurationSchema() (ObjectSchema, error) {
+	return a.schema, nil
+}
+
+func (a *testAction) OutputType() (Type, error) {
+	return a.outputType, nil
+}
+
+func (a *testAction) Evaluate(_ context.Context, _ string, input cty.Value) (cty.Value, error) {
+	return a.evaluate(input)
+}
+
+// credentialsSchema is a function rather than a variable because String is set in init.
+func credentialsSchema() ObjectSchema {
+	return ObjectSchema{Attributes: map[string]Schema{
+		"user":    &AttrSchema{Name: "user", Required: true, Type: String},
+		"api_key": &AttrSchema{Name: "api_key", Required: true, Type: String, Sensitive: true},
+	}}
+}
+
+func TestSensitiveAttributesAreMarked(t *testing.T) {
+	val, err := MapInputToCtyValue([]byte(`{"user":"ada","api_key":"hunter2"}`), credentialsSchema())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSensitive(val.GetAttr("api_key")) || IsSensitive(val.GetAttr("user")) {
+		t.Errorf("got marks %#v", val)
+	}
+	if got, want := FormatValue(val), `{api_key = (sensitive value), user = "ada"}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestSensitiveTypesAreMarked(t *testing.T) {
+	secret := String
+	secret.Sensitive = true
+	outputType := List(Object(map[string]Type{"id": String, "token": secret}))
+	val, err := MapByteStringToCtyValue([]byte(`[{"id":"a","token":"t1"},{"id":"b","token":"t2"}]`), outputType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := FormatValue(val), `[{id = "a", token = (sensitive value)}, {id = "b", token = (sensitive value)}]`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestActionErrorsAreRedacted(t *testing.T) {
+	provider := NewProvider(WithAction("login", &testAction{
+		schema:     credentialsSchema(),
+		outputType: String,
+		evaluate: func(input cty.Value) (cty.Value, error) {
+			key, _ := input.GetAttr("api_key").Unmark()
+			return cty.NilVal, Unauthorized(errors.New("key " + key.AsString() + " was rejected"))
+		},
+	}))
+	_, err := provider.ActionEvaluate(context.Background(), "ctx", "login", []byte(`{"user":"ada","api_key":"hunter2"}`))
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if strings.Contains(err.Error(), "hunter2") || !strings.Contains(err.Error(), REDACTED) {
+		t.Errorf("got error %q, want the key redacted", err)
+	}
+	if ErrorKindOf(err) != ErrorKindUnauthorized {
+		t.Errorf("got kind %s, want %s", ErrorKindOf(err), ErrorKindUnauthorized)
+	}
+}
+
+func TestSensitiveOutputsRoundTrip(t *testing.T) {
+	outputType := Object(map[string]Type{"user": String, "api_key": String})
+	action := &testAction{
+		schema:     credentialsSchema(),
+		outputType: outputType,
+		evaluate: func(input cty.Value) (cty.Value, error) {
+			return input, nil
+		},
+	}
+	for name, dispense := range transports {
+		t.Run(name, func(t *testing.T) {
+			provider := dispense(t, NewProvider(WithAction("echo", action)))
+			output, err := provider.ActionEvaluate(context.Background(), "ctx", "echo", []byte(`{"user":"ada","api_key":"hunter2"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(output.SensitivePaths) != 1 || !output.SensitivePaths[0].Equals(cty.GetAttrPath("api_key")) {
+				t.Fatalf("got sensitive paths %#v", output.SensitivePaths)
+			}
+			val, err := output.Decode(outputType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !IsSensitive(val.GetAttr("api_key")) || IsSensitive(val.GetAttr("user")) {
+				t.Errorf("got marks %#v", val)
+			}
+		})
+	}
+}
+
+func TestDiagnosticPathsRedactSensitiveKeys(t *testing.T) {
+	path := cty.GetAttrPath("tokens").Index(MarkSensitive(cty.StringVal("hunter2")))
+	diags := Diagnostics{{Severity: DiagError, Summary: "Invalid token", Attribute: path}}
+	if got, want := formatPath(path), `tokens["(sensitive value)"]`; got != want {
+		t.Errorf("got path %s, want %s", got, want)
+	}
+	data, err := diags[0].MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "hunter2") {
+		t.Errorf("got %s, want the key redacted", data)
+	}
+}
@@ -0,0 +1,285 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+)
+
+const (
+	//PROVIDER_PLUGIN_NAME is the name providers are registered under in the plugin sets returned
+	//by VersionedPlugins, and dispensed by on the runner side. It is part of the contract between
+	//providers and the runner, and must not change.
+	PROVIDER_PLUGIN_NAME = "provider"
+	//LOG_LEVEL_ENV is the environment variable that sets the level of the logger created by Serve,
+	//such as "debug" or "trace". The default level is "info".
+	LOG_LEVEL_ENV = "SB_LOG_LEVEL"
+	//DEFAULT_SHUTDOWN_TIMEOUT is how long Serve waits for calls in progress to finish after it
+	//receives SIGTERM, unless WithShutdownTimeout says otherwise.
+	DEFAULT_SHUTDOWN_TIMEOUT = 30 * time.Second
+)
+
+// responseFlushDelay is how long Serve waits after the last call in progress has returned, so
+// that the transport can send its response before the process exits.
+const responseFlushDelay = 100 * time.Millisecond
+
+// ErrShuttingDown is returned, as a Retryable error, for calls that arrive after a provider
+// started by Serve has been asked to shut down.
+var ErrShuttingDown = errors.New("provider is shutting down")
+
+// ServeOption configures Serve.
+type ServeOption func(*serveConfig)
+
+type serveConfig struct {
+	logger          hclog.Logger
+	netRPC          bool
+	shutdownTimeout time.Duration
+	debug           bool
+}
+
+// WithServeLogger sets the logger used by go-plugin and returned by hclog.Default. By default,
+// Serve logs JSON to stderr at the level in LOG_LEVEL_ENV, which the runner reads and forwards
+// to its own logs.
+func WithServeLogger(logger hclog.Logger) ServeOption {
+	return func(c *serveConfig) {
+		c.logger = logger
+	}
+}
+
+// WithNetRPC serves the provider over net/rpc instead of gRPC, for runners that don't allow
+// plugin.ProtocolGRPC. go-plugin picks the transport on the provider's side, so the runner can't
+// ask for net/rpc.
+func WithNetRPC() ServeOption {
+	return func(c *serveConfig) {
+		c.netRPC = true
+	}
+}
+
+// WithShutdownTimeout sets how long Serve waits for calls in progress to finish after it
+// receives SIGTERM, before the process exits regardless.
+func WithShutdownTimeout(timeout time.Duration) ServeOption {
+	return func(c *serveConfig) {
+		c.shutdownTimeout = timeout
+	}
+}
+
+// WithDebug serves the provider in debug mode, for running it under a debugger such as Delve.
+// Rather than being started by the runner, the provider is started on its own and prints a
+// ReattachConfig to stdout, in the form of a REATTACH_ENV assignment, that the runner connects
+// to with WithReattach. It keeps running, for any number of runner sessions, until it's
+// interrupted. Logs are written to stderr as text.
+func WithDebug() ServeOption {
+	return func(c *serveConfig) {
+		c.debug = true
+	}
+}
+
+// Serve serves provider to the runner, and is meant to be the only call in a provider's main
+// function. It performs the go-plugin handshake with HandshakeConfig, and serves provider under
+// PROVIDER_PLUGIN_NAME on every protocol version in VersionedPlugins. Wrap a Provider with
+// AdaptProvider to serve it.
+//
+// Serve doesn't return until the runner is done with the provider. Interrupts are ignored, since
+// the runner receives them too and stops the provider itself. On SIGTERM, new calls fail with
+// ErrShuttingDown, and the process exits once the calls in progress have finished or the
+// shutdown timeout has passed. See WithDebug for serving a provider that isn't started by the
+// runner.
+func Serve(provider ProviderV3, opts ...ServeOption) {
+	config := &serveConfig{shutdownTimeout: DEFAULT_SHUTDOWN_TIMEOUT}
+	for _, opt := range opts {
+		opt(config)
+	}
+	switch {
+	case config.logger != nil:
+	case config.debug:
+		config.logger = debugLogger()
+	default:
+		config.logger = hclog.New(&hclog.LoggerOptions{
+			Name:       PROVIDER_PLUGIN_NAME,
+			Level:      hclog.LevelFromString(os.Getenv(LOG_LEVEL_ENV)),
+			Output:     os.Stderr,
+			JSONFormat: true,
+		})
+	}
+	hclog.SetDefault(config.logger)
+
+	served := &servedProvider{impl: provider}
+	serveConfig := &plugin.ServeConfig{
+		HandshakeConfig:  HandshakeConfig,
+		VersionedPlugins: VersionedPlugins(served),
+		Logger:           config.logger,
+	}
+	if !config.netRPC {
+		serveConfig.GRPCServer = plugin.DefaultGRPCServer
+	}
+	if config.debug {
+		serveDebug(serveConfig, served, config)
+		return
+	}
+	//SIGTERM is registered before serving, so that one sent as soon as the provider is up isn't
+	//handled by the default action, which exits without draining
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM)
+	go served.shutdownOnSignal(signals, config.logger, config.shutdownTimeout)
+	plugin.Serve(serveConfig)
+}
+
+// servedProvider keeps count of the calls in progress, so that Serve can let them finish before
+// the process exits.
+type servedProvider struct {
+	impl     ProviderV3
+	mu       sync.Mutex
+	closing  bool
+	inFlight sync.WaitGroup
+}
+
+// shutdownOnSignal drains the provider and exits when a signal is received on signals.
+func (p *servedProvider) shutdownOnSignal(signals <-chan os.Signal, logger hclog.Logger, timeout time.Duration) {
+	sig := <-signals
+	logger.Info("shutting down", "signal", sig.String(), "timeout", timeout.String())
+	if p.drain(timeout) {
+		time.Sleep(responseFlushDelay)
+	} else {
+		logger.Warn("calls still in progress at shutdown timeout")
+	}
+	os.Exit(0)
+}
+
+// drain stops new calls from starting, and waits for the calls in progress to finish. It reports
+// whether they did so before timeout.
+func (p *servedProvider) drain(timeout time.Duration) bool {
+	p.mu.Lock()
+	p.closing = true
+	p.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		p.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// track calls fn, unless the provider is shutting down.
+func track[T any](p *servedProvider, fn func() (T, error)) (T, error) {
+	p.mu.Lock()
+	if p.closing {
+		p.mu.Unlock()
+		var zero T
+		return zero, Retryable(ErrShuttingDown, 0)
+	}
+	//Add must happen under mu, so that drain, which sets closing under mu before it waits, either
+	//sees this call or has already turned it away. Otherwise Wait could return while it starts.
+	p.inFlight.Add(1)
+	p.mu.Unlock()
+	defer p.inFlight.Done()
+	return fn()
+}
+
+func (p *servedProvider) Init(ctx context.Context, runnerProvider RunnerProviderV3) (ProviderConfig, error) {
+	return track(p, func() (ProviderConfig, error) {
+		return p.impl.Init(ctx, runnerProvider)
+	})
+}
+
+func (p *servedProvider) InitSchema(ctx context.Context) (ObjectSchema, error) {
+	return track(p, func() (ObjectSchema, error) {
+		return p.impl.InitSchema(ctx)
+	})
+}
+
+func (p *servedProvider) ActionNames(ctx context.Context) ([]string, error) {
+	return track(p, func() ([]string, error) {
+		return p.impl.ActionNames(ctx)
+	})
+}
+
+func (p *servedProvider) ActionEvaluate(ctx context.Context, contextId string, name string, input []byte) (ActionOutput, error) {
+	return track(p, func() (ActionOutput, error) {
+		return p.impl.ActionEvaluate(ctx, contextId, name, input)
+	})
+}
+
+func (p *servedProvider) ActionConfigurationSchema(ctx context.Context, name string) (ObjectSchema, error) {
+	return track(p, func() (ObjectSchema, error) {
+		return p.impl.ActionConfigurationSchema(ctx, name)
+	})
+}
+
+func (p *servedProvider) ActionOutputType(ctx context.Context, name string) (Type, error) {
+	return track(p, func() (Type, error) {
+		return p.impl.ActionOutputType(ctx, name)
+	})
+}
+
+func (p *servedProvider) ActionMetadata(ctx context.Context, name string) (Metadata, error) {
+	return track(p, func() (Metadata, error) {
+		return p.impl.ActionMetadata(ctx, name)
+	})
+}
+
+func (p *servedProvider) TriggerKeyNames(ctx context.Context) ([]string, error) {
+	return track(p, func() ([]string, error) {
+		return p.impl.TriggerKeyNames(ctx)
+	})
+}
+
+func (p *servedProvider) TriggerConfigurationSchema(ctx context.Context) (ObjectSchema, error) {
+	return track(p, func() (ObjectSchema, error) {
+		return p.impl.TriggerConfigurationSchema(ctx)
+	})
+}
+
+func (p *servedProvider) MapPayloadToTriggerKey(ctx context.Context, payload []byte) (string, error) {
+	return track(p, func() (string, error) {
+		return p.impl.MapPayloadToTriggerKey(ctx, payload)
+	})
+}
+
+func (p *servedProvider) TriggerOutputType(ctx context.Context, key string) (Type, error) {
+	return track(p, func() (Type, error) {
+		return p.impl.TriggerOutputType(ctx, key)
+	})
+}
+
+func (p *servedProvider) TriggerMetadata(ctx context.Context, key string) (Metadata, error) {
+	return track(p, func() (Metadata, error) {
+		return p.impl.TriggerMetadata(ctx, key)
+	})
+}
+
+func (p *servedProvider) CreateSubscription(ctx context.Context, contextId string, input []byte) ([]byte, error) {
+	return track(p, func() ([]byte, error) {
+		return p.impl.CreateSubscription(ctx, contextId, input)
+	})
+}
+
+func (p *servedProvider) ReadSubscription(ctx context.Context, contextId string, subscriptionId string) ([]byte, error) {
+	return track(p, func() ([]byte, error) {
+		return p.impl.ReadSubscription(ctx, contextId, subscriptionId)
+	})
+}
+
+func (p *servedProvider) UpdateSubscription(ctx context.Context, contextId string, subscriptionId string, input []byte) ([]byte, error) {
+	return track(p, func() ([]byte, error) {
+		return p.impl.UpdateSubscription(ctx, contextId, subscriptionId, input)
+	})
+}
+
+func (p *servedProvider) DeleteSubscription(ctx context.Context, contextId string, subscriptionId string) error {
+	_, err := track(p, func() (struct{}, error) {
+		return struct{}{}, p.impl.DeleteSubscription(ctx, contextId, subscriptionId)
+	})
+	return err
+}
@@ -0,0 +1,83 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDrainWaitsForCallsInProgress(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	served := &servedProvider{impl: NewProvider(WithAction("wait", &testAction{
+		schema:     ObjectSchema{Attributes: map[string]Schema{}},
+		outputType: String,
+		evaluate: func(cty.Value) (cty.Value, error) {
+			close(started)
+			<-release
+			return cty.StringVal("done"), nil
+		},
+	}))}
+	ctx := context.Background()
+	result := make(chan error, 1)
+	go func() {
+		_, err := served.ActionEvaluate(ctx, "ctx", "wait", []byte(`{}`))
+		result <- err
+	}()
+	<-started
+
+	drained := make(chan bool, 1)
+	go func() {
+		drained <- served.drain(time.Minute)
+	}()
+	//drain sets closing before it waits, so new calls are turned away from here on
+	for {
+		served.mu.Lock()
+		closing := served.closing
+		served.mu.Unlock()
+		if closing {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	_, err := served.ActionNames(ctx)
+	if !errors.Is(err, ErrShuttingDown) || ErrorKindOf(err) != ErrorKindRetryable {
+		t.Errorf("got %v while shutting down, want a retryable ErrShuttingDown", err)
+	}
+	select {
+	case <-drained:
+		t.Fatal("drain returned while a call was in progress")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-result; err != nil {
+		t.Errorf("got %v from the call in progress", err)
+	}
+	if !<-drained {
+		t.Error("drain timed out")
+	}
+}
+
+func TestDrainTimesOut(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	served := &servedProvider{impl: NewProvider(WithAction("wait", &testAction{
+		schema:     ObjectSchema{Attributes: map[string]Schema{}},
+		outputType: String,
+		evaluate: func(cty.Value) (cty.Value, error) {
+			close(started)
+			<-release
+			return cty.StringVal("done"), nil
+		},
+	}))}
+	go served.ActionEvaluate(context.Background(), "ctx", "wait", []byte(`{}`))
+	<-started
+	if served.drain(10 * time.Millisecond) {
+		t.Error("drain reported the call in progress as finished")
+	}
+}
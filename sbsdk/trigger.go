@@ -0,0 +1,214 @@
+package sbsdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	SUBSCRIPTION_ID_KEY    = "subscription_id"
+	SUBSCRIPTION_KEY_KEY   = "trigger_key"
+	SUBSCRIPTION_STATE_KEY = "state"
+)
+
+// TriggerRegistry composes Trigger implementations, keyed by trigger key, into the flat trigger
+// methods of ProviderV3. Providers built with NewProvider use one internally, and hand-written
+// providers may embed one to get the same behaviour.
+//
+// The trigger configuration schema is an object with one optional block per trigger key, so a
+// subscription's input decides which trigger it belongs to. Subscription state is returned as an
+// object holding the subscription_id, the trigger_key and the trigger's own state, where the
+// subscription_id is the trigger's id prefixed by its key so that later calls can be routed.
+type TriggerRegistry struct {
+	triggers map[string]Trigger
+}
+
+func NewTriggerRegistry() *TriggerRegistry {
+	return &TriggerRegistry{
+		triggers: make(map[string]Trigger),
+	}
+}
+
+// Register adds a Trigger under the given key. Registering the same key twice replaces the
+// earlier trigger. The key names the trigger's block in the configuration schema, and prefixes
+// its subscription ids, so Register panics if it isn't a valid hcl identifier, such as a key
+// containing "/".
+func (r *TriggerRegistry) Register(key string, trigger Trigger) {
+	if !hclsyntax.ValidIdentifier(key) {
+		panic(fmt.Sprintf("sbsdk: invalid trigger key %q: must be a valid hcl identifier", key))
+	}
+	r.triggers[key] = trigger
+}
+
+func (r *TriggerRegistry) TriggerKeyNames(_ context.Context) ([]string, error) {
+	keys := make([]string, 0, len(r.triggers))
+	for key := range r.triggers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (r *TriggerRegistry) TriggerConfigurationSchema(ctx context.Context) (ObjectSchema, error) {
+	out := ObjectSchema{Attributes: make(map[string]Schema, len(r.triggers))}
+	for key, trigger := range r.triggers {
+		schema, err := trigger.ConfigurationSchema()
+		if err != nil {
+			return ObjectSchema{}, err
+		}
+		out.Attributes[key] = OptionalBlockSchema(key, &schema)
+	}
+	keys, _ := r.TriggerKeyNames(ctx)
+	if len(keys) > 0 {
+		out.AddConstraints(ExactlyOneOf(keys...))
+	}
+	if diags := CheckSchema(&out); diags.HasErrors() {
+		return ObjectSchema{}, diags
+	}
+	return out, nil
+}
+
+// MapPayloadToTriggerKey asks each trigger, in key order, whether the payload belongs to it
+// and returns the first key that matches.
+func (r *TriggerRegistry) MapPayloadToTriggerKey(ctx context.Context, payload []byte) (string, error) {
+	keys, _ := r.TriggerKeyNames(ctx)
+	for _, key := range keys {
+		ok, err := r.triggers[key].MatchesPayload(ctx, payload)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return key, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no trigger matches payload", ErrUnknownTrigger)
+}
+
+func (r *TriggerRegistry) TriggerOutputType(_ context.Context, key string) (Type, error) {
+	trigger, err := r.trigger(key)
+	if err != nil {
+		return Type{}, err
+	}
+	return trigger.OutputType()
+}
+
+func (r *TriggerRegistry) TriggerMetadata(_ context.Context, key string) (Metadata, error) {
+	trigger, err := r.trigger(key)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return metadataOf(trigger), nil
+}
+
+func (r *TriggerRegistry) CreateSubscription(ctx context.Context, contextId string, input []byte) ([]byte, error) {
+	inputVal, err := r.decodeInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for key := range r.triggers {
+		if !inputVal.GetAttr(key).IsNull() {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) != 1 {
+		return nil, fmt.Errorf("subscription input must configure exactly one trigger, got %d", len(keys))
+	}
+	key := keys[0]
+	id, state, err := r.triggers[key].CreateSubscription(ctx, contextId, inputVal.GetAttr(key))
+	if err != nil {
+		return nil, redactError(err, inputVal)
+	}
+	return r.encodeState(key, id, state)
+}
+
+func (r *TriggerRegistry) ReadSubscription(ctx context.Context, contextId string, subscriptionId string) ([]byte, error) {
+	key, id, err := r.splitSubscriptionId(subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	state, err := r.triggers[key].ReadSubscription(ctx, contextId, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.encodeState(key, id, state)
+}
+
+func (r *TriggerRegistry) UpdateSubscription(ctx context.Context, contextId string, subscriptionId string, input []byte) ([]byte, error) {
+	key, id, err := r.splitSubscriptionId(subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	inputVal, err := r.decodeInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if inputVal.GetAttr(key).IsNull() {
+		return nil, fmt.Errorf("subscription input must configure trigger %q", key)
+	}
+	state, err := r.triggers[key].UpdateSubscription(ctx, contextId, id, inputVal.GetAttr(key))
+	if err != nil {
+		return nil, redactError(err, inputVal)
+	}
+	return r.encodeState(key, id, state)
+}
+
+func (r *TriggerRegistry) DeleteSubscription(ctx context.Context, contextId string, subscriptionId string) error {
+	key, id, err := r.splitSubscriptionId(subscriptionId)
+	if err != nil {
+		return err
+	}
+	return r.triggers[key].DeleteSubscription(ctx, contextId, id)
+}
+
+func (r *TriggerRegistry) trigger(key string) (Trigger, error) {
+	trigger, ok := r.triggers[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownTrigger, key)
+	}
+	return trigger, nil
+}
+
+func (r *TriggerRegistry) decodeInput(ctx context.Context, input []byte) (cty.Value, error) {
+	schema, err := r.TriggerConfigurationSchema(ctx)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return MapInputToCtyValue(input, schema)
+}
+
+func (r *TriggerRegistry) encodeState(key string, id string, state cty.Value) ([]byte, error) {
+	stateType, err := r.triggers[key].StateType()
+	if err != nil {
+		return nil, err
+	}
+	envelopeType := Object(map[string]Type{
+		SUBSCRIPTION_ID_KEY:    String,
+		SUBSCRIPTION_KEY_KEY:   String,
+		SUBSCRIPTION_STATE_KEY: stateType,
+	})
+	envelope := cty.ObjectVal(map[string]cty.Value{
+		SUBSCRIPTION_ID_KEY:    cty.StringVal(key + "/" + id),
+		SUBSCRIPTION_KEY_KEY:   cty.StringVal(key),
+		SUBSCRIPTION_STATE_KEY: state,
+	})
+	return MapCtyValueToByteString(envelope, envelopeType)
+}
+
+// splitSubscriptionId separates a subscription id issued by the registry into the trigger key
+// and the id issued by the trigger itself.
+func (r *TriggerRegistry) splitSubscriptionId(subscriptionId string) (string, string, error) {
+	key, id, ok := strings.Cut(subscriptionId, "/")
+	if !ok {
+		return "", "", fmt.Errorf("malformed subscription id %q", subscriptionId)
+	}
+	if _, err := r.trigger(key); err != nil {
+		return "", "", err
+	}
+	return key, id, nil
+}
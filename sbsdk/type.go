@@ -2,22 +2,34 @@ package sbsdk
 
 import (
 	"encoding/gob"
-	"errors"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 )
 
 const (
-	NUMBER_TYPE            = "number"
-	BOOLEAN_TYPE           = "bool"
-	STRING_TYPE            = "string"
-	OBJECT_TYPE            = "object"
-	MAP_TYPE               = "map"
-	LIST_TYPE              = "list"
-	DYNAMIC_TYPE           = "dynamic"
-	INVALID_TYPE           = "invalid"
-	TYPE_NAME_KEY          = "type_name"
-	TYPE_NESTED_VALUES_KEY = "nested_values"
-	TYPE_INTERNAL_TYPE_KEY = "internal_type"
+	NUMBER_TYPE             = "number"
+	BOOLEAN_TYPE            = "bool"
+	STRING_TYPE             = "string"
+	OBJECT_TYPE             = "object"
+	MAP_TYPE                = "map"
+	LIST_TYPE               = "list"
+	SET_TYPE                = "set"
+	TUPLE_TYPE              = "tuple"
+	DYNAMIC_TYPE            = "dynamic"
+	INVALID_TYPE            = "invalid"
+	TYPE_NAME_KEY           = "type_name"
+	TYPE_NESTED_VALUES_KEY  = "nested_values"
+	TYPE_INTERNAL_TYPE_KEY  = "internal_type"
+	TYPE_ELEMENT_TYPES_KEY  = "element_types"
+	TYPE_OPTIONAL_ATTRS_KEY = "optional_attrs"
+	TYPE_DEFAULTS_KEY       = "defaults"
+	TYPE_SENSITIVE_KEY      = "sensitive"
 )
 
 // typeImpl is an interface implemented by the Type struct that can be
@@ -32,96 +44,318 @@ type typeImpl interface {
 // Type is a serializable data structure that helps the CLI and runner understand what data structures
 // in configuration should look like.
 type Type struct {
+	Metadata
 	//TypeName is a string representation of the underlying type
-	TypeName string
+	TypeName string `json:"type_name"`
 	//NestedValues is used exclusively for an "object" type
-	NestedValues *map[string]Type
+	NestedValues *map[string]Type `json:"nested_values,omitempty"`
+	//OptionalAttrs is used exclusively for an "object" type, and names the attributes of NestedValues
+	//that may be omitted. Omitted attributes are null unless they have a default.
+	OptionalAttrs *[]string `json:"optional_attrs,omitempty"`
+	//Defaults is used exclusively for an "object" type, and holds the cty JSON encoded value of each
+	//attribute that is filled in when the attribute is omitted or null. Attributes with a default are optional.
+	Defaults *map[string]json.RawMessage `json:"defaults,omitempty"`
+
+	//InternalType is used to represent what type the value of a list, set or map value is.
+	InternalType *Type `json:"internal_type,omitempty"`
 
-	//InternalType is used to represent what type the value of a list or map value is.
-	InternalType *Type
+	//ElementTypes is used exclusively for a "tuple" type, and holds the type of each element in order
+	ElementTypes *[]Type `json:"element_types,omitempty"`
+
+	//Sensitive marks values of this type with SENSITIVE_MARK when they are decoded, so that they are
+	//redacted from logs, error messages and diagnostics
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
-// ValConformsToTypeStructure is a recursive function that checks whether a cty.Value
-// object comforms to the appropriate structure expected to generate a Type object
-func valConformsToTypeStructure(val cty.Value, isRoot bool) error {
+// typeDescriptionKeys lists, for each type name, the attributes a type description of that type
+// may have besides TYPE_NAME_KEY and TYPE_SENSITIVE_KEY. It is also the list of valid type names.
+var typeDescriptionKeys = map[string][]string{
+	NUMBER_TYPE:  nil,
+	BOOLEAN_TYPE: nil,
+	STRING_TYPE:  nil,
+	DYNAMIC_TYPE: nil,
+	OBJECT_TYPE:  {TYPE_NESTED_VALUES_KEY, TYPE_OPTIONAL_ATTRS_KEY, TYPE_DEFAULTS_KEY},
+	MAP_TYPE:     {TYPE_INTERNAL_TYPE_KEY},
+	LIST_TYPE:    {TYPE_INTERNAL_TYPE_KEY},
+	SET_TYPE:     {TYPE_INTERNAL_TYPE_KEY},
+	TUPLE_TYPE:   {TYPE_ELEMENT_TYPES_KEY},
+}
+
+// typeNames returns the valid type names in a stable order, for error messages.
+func typeNames() []string {
+	names := make([]string, 0, len(typeDescriptionKeys))
+	for name := range typeDescriptionKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
 
+// ValConformsToTypeStructure is a recursive function that checks whether a cty.Value
+// object comforms to the appropriate structure expected to generate a Type object.
+// Errors are cty.PathErrors pointing at the offending part of val.
+func valConformsToTypeStructure(val cty.Value, path cty.Path, isRoot bool) error {
+	if val.IsNull() || !val.IsWhollyKnown() || !val.Type().IsObjectType() {
+		return path.NewErrorf("a type must be described by an object with a %s attribute", TYPE_NAME_KEY)
+	}
 	if !val.Type().HasAttribute(TYPE_NAME_KEY) || !val.GetAttr(TYPE_NAME_KEY).Type().Equals(cty.String) {
-		return errors.New("invalid value to convert to type")
+		return path.NewErrorf("a type must be described by an object with a %s attribute", TYPE_NAME_KEY)
 	}
 	typeNameVal := val.GetAttr(TYPE_NAME_KEY).AsString()
+	allowedKeys, ok := typeDescriptionKeys[typeNameVal]
+	if !ok {
+		return path.GetAttr(TYPE_NAME_KEY).NewErrorf("unknown type name %q, which must be one of %s",
+			typeNameVal, strings.Join(typeNames(), ", "))
+	}
+	for key := range val.Type().AttributeTypes() {
+		if key == TYPE_NAME_KEY || key == TYPE_SENSITIVE_KEY {
+			continue
+		}
+		allowed := false
+		for _, allowedKey := range allowedKeys {
+			allowed = allowed || key == allowedKey
+		}
+		if !allowed {
+			return path.GetAttr(key).NewErrorf("%s is not a valid attribute of a %s type", key, typeNameVal)
+		}
+	}
+	if val.Type().HasAttribute(TYPE_SENSITIVE_KEY) && !val.GetAttr(TYPE_SENSITIVE_KEY).Type().Equals(cty.Bool) {
+		return path.GetAttr(TYPE_SENSITIVE_KEY).NewErrorf("%s must be a bool", TYPE_SENSITIVE_KEY)
+	}
 
 	if isRoot && typeNameVal != OBJECT_TYPE {
-		return errors.New("root type must be an object")
+		return path.GetAttr(TYPE_NAME_KEY).NewErrorf("root type must be an object")
 	}
 	if typeNameVal == OBJECT_TYPE {
+		nestedPath := path.GetAttr(TYPE_NESTED_VALUES_KEY)
 		if !(val.Type().HasAttribute(TYPE_NESTED_VALUES_KEY) && val.GetAttr(TYPE_NESTED_VALUES_KEY).Type().IsObjectType()) {
-			return errors.New("objects must have a nested_values attribute set to an object value")
+			return nestedPath.NewErrorf("objects must have a nested_values attribute set to an object value")
 		}
-		iter := val.GetAttr(TYPE_NESTED_VALUES_KEY).ElementIterator()
-		for iter.Next() {
-			_, el := iter.Element()
-			err := valConformsToTypeStructure(el, false)
+		for name := range val.GetAttr(TYPE_NESTED_VALUES_KEY).Type().AttributeTypes() {
+			err := valConformsToTypeStructure(val.GetAttr(TYPE_NESTED_VALUES_KEY).GetAttr(name), nestedPath.GetAttr(name), false)
 			if err != nil {
 				return err
 			}
 		}
+		if val.Type().HasAttribute(TYPE_OPTIONAL_ATTRS_KEY) {
+			optionalPath := path.GetAttr(TYPE_OPTIONAL_ATTRS_KEY)
+			optionalVal := val.GetAttr(TYPE_OPTIONAL_ATTRS_KEY)
+			if !isSequenceType(optionalVal.Type()) {
+				return optionalPath.NewErrorf("optional_attrs must be a list of attribute names")
+			}
+			iter := optionalVal.ElementIterator()
+			for iter.Next() {
+				k, el := iter.Element()
+				if !el.Type().Equals(cty.String) || !val.GetAttr(TYPE_NESTED_VALUES_KEY).Type().HasAttribute(el.AsString()) {
+					return optionalPath.Index(k).NewErrorf("optional_attrs must only name attributes in nested_values")
+				}
+			}
+		}
+		if val.Type().HasAttribute(TYPE_DEFAULTS_KEY) {
+			defaultsPath := path.GetAttr(TYPE_DEFAULTS_KEY)
+			defaultsVal := val.GetAttr(TYPE_DEFAULTS_KEY)
+			if !defaultsVal.Type().IsObjectType() {
+				return defaultsPath.NewErrorf("defaults must be an object value")
+			}
+			for name := range defaultsVal.Type().AttributeTypes() {
+				if !val.GetAttr(TYPE_NESTED_VALUES_KEY).Type().HasAttribute(name) {
+					return defaultsPath.GetAttr(name).NewErrorf("defaults must only have attributes in nested_values")
+				}
+			}
+		}
 	}
 
-	if typeNameVal == MAP_TYPE || typeNameVal == LIST_TYPE {
-		if !(val.Type().HasAttribute(TYPE_INTERNAL_TYPE_KEY) && val.GetAttr(TYPE_INTERNAL_TYPE_KEY).Type().IsObjectType()) {
-			return errors.New("maps must have an internal_type attribute set to an object value")
+	if typeNameVal == MAP_TYPE || typeNameVal == LIST_TYPE || typeNameVal == SET_TYPE {
+		if !val.Type().HasAttribute(TYPE_INTERNAL_TYPE_KEY) {
+			return path.GetAttr(TYPE_INTERNAL_TYPE_KEY).NewErrorf("maps, lists and sets must have an internal_type attribute set to an object value")
 		}
-		err := valConformsToTypeStructure(val.GetAttr(TYPE_INTERNAL_TYPE_KEY), false)
+		err := valConformsToTypeStructure(val.GetAttr(TYPE_INTERNAL_TYPE_KEY), path.GetAttr(TYPE_INTERNAL_TYPE_KEY), false)
 		if err != nil {
 			return err
 		}
 	}
+
+	if typeNameVal == TUPLE_TYPE {
+		elementsPath := path.GetAttr(TYPE_ELEMENT_TYPES_KEY)
+		if !(val.Type().HasAttribute(TYPE_ELEMENT_TYPES_KEY) && isSequenceType(val.GetAttr(TYPE_ELEMENT_TYPES_KEY).Type())) {
+			return elementsPath.NewErrorf("tuples must have an element_types attribute set to a list of object values")
+		}
+		iter := val.GetAttr(TYPE_ELEMENT_TYPES_KEY).ElementIterator()
+		for iter.Next() {
+			k, el := iter.Element()
+			err := valConformsToTypeStructure(el, elementsPath.Index(k), false)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// isSequenceType reports whether values of t are ordered sequences, which is how a list of
+// types is represented once decoded from configuration.
+func isSequenceType(t cty.Type) bool {
+	return t.IsTupleType() || t.IsListType()
+}
+
+// FromCtyToType converts a type description, such as one written in hcl by a user, into a Type.
+// A type description is an object with a type_name attribute, and the nested_values,
+// optional_attrs, defaults, internal_type, element_types and sensitive attributes that its type
+// needs, each holding the type descriptions of nested types. When isRoot is set the type must be
+// an object. Descriptions that are malformed or use unknown type names are rejected with
+// Diagnostics pointing at the offending attribute. FromTypeToCty is the reverse conversion.
 func FromCtyToType(val cty.Value, isRoot bool) (*Type, error) {
-	if isRoot {
-		err := valConformsToTypeStructure(val, true)
-		if err != nil {
-			return nil, err
-		}
+	err := valConformsToTypeStructure(val, nil, isRoot)
+	if err != nil {
+		return nil, valueDiagnostics("Invalid type description", err)
 	}
-	switch val.GetAttr(TYPE_NAME_KEY).AsString() {
+	out, err := typeFromCty(val, nil)
+	if err != nil {
+		return nil, valueDiagnostics("Invalid type description", err)
+	}
+	return &out, nil
+}
+
+// typeFromCty converts a type description that has been checked by valConformsToTypeStructure.
+func typeFromCty(val cty.Value, path cty.Path) (Type, error) {
+	out := Type{
+		TypeName: val.GetAttr(TYPE_NAME_KEY).AsString(),
+	}
+	if val.Type().HasAttribute(TYPE_SENSITIVE_KEY) {
+		out.Sensitive = val.GetAttr(TYPE_SENSITIVE_KEY).True()
+	}
+	switch out.TypeName {
 	case OBJECT_TYPE:
-		iter := val.GetAttr(TYPE_NESTED_VALUES_KEY).ElementIterator()
 		nestedOut := make(map[string]Type)
+		for name := range val.GetAttr(TYPE_NESTED_VALUES_KEY).Type().AttributeTypes() {
+			ty, err := typeFromCty(val.GetAttr(TYPE_NESTED_VALUES_KEY).GetAttr(name), path.GetAttr(TYPE_NESTED_VALUES_KEY).GetAttr(name))
+			if err != nil {
+				return Invalid, err
+			}
+			nestedOut[name] = ty
+		}
+		out.NestedValues = &nestedOut
+		if val.Type().HasAttribute(TYPE_OPTIONAL_ATTRS_KEY) {
+			var optional []string
+			iter := val.GetAttr(TYPE_OPTIONAL_ATTRS_KEY).ElementIterator()
+			for iter.Next() {
+				_, v := iter.Element()
+				optional = append(optional, v.AsString())
+			}
+			out.OptionalAttrs = &optional
+		}
+		if val.Type().HasAttribute(TYPE_DEFAULTS_KEY) {
+			err := out.setDefaults(val.GetAttr(TYPE_DEFAULTS_KEY).AsValueMap())
+			if err != nil {
+				return Invalid, path.GetAttr(TYPE_DEFAULTS_KEY).NewError(err)
+			}
+		}
+	case TUPLE_TYPE:
+		iter := val.GetAttr(TYPE_ELEMENT_TYPES_KEY).ElementIterator()
+		elementTypes := make([]Type, 0, val.GetAttr(TYPE_ELEMENT_TYPES_KEY).LengthInt())
 		for iter.Next() {
 			k, v := iter.Element()
-			ty, err := FromCtyToType(v, false)
+			ty, err := typeFromCty(v, path.GetAttr(TYPE_ELEMENT_TYPES_KEY).Index(k))
 			if err != nil {
-				return nil, err
+				return Invalid, err
 			}
-			nestedOut[k.AsString()] = *ty
+			elementTypes = append(elementTypes, ty)
 		}
-		out := Type{
-			TypeName:     OBJECT_TYPE,
-			NestedValues: &nestedOut,
-			InternalType: nil,
+		out.ElementTypes = &elementTypes
+	case LIST_TYPE, MAP_TYPE, SET_TYPE:
+		internalType, err := typeFromCty(val.GetAttr(TYPE_INTERNAL_TYPE_KEY), path.GetAttr(TYPE_INTERNAL_TYPE_KEY))
+		if err != nil {
+			return Invalid, err
 		}
-		return &out, nil
-	case LIST_TYPE:
-	case MAP_TYPE:
-		internalType, err := FromCtyToType(val.GetAttr(TYPE_INTERNAL_TYPE_KEY), false)
+		out.InternalType = &internalType
+	}
+	return out, nil
+}
+
+// FromTypeToCty converts t into a type description that FromCtyToType converts back into an
+// equivalent Type, so that types can be written out in hcl. Diagnostics are returned if t, or
+// a type nested in it, has an unknown type name or is missing the nested types its name needs.
+func FromTypeToCty(t Type) (cty.Value, error) {
+	val, err := typeToCty(t, nil)
+	if err != nil {
+		return cty.NilVal, valueDiagnostics("Invalid type", err)
+	}
+	return val, nil
+}
+
+func typeToCty(t Type, path cty.Path) (cty.Value, error) {
+	attrs := map[string]cty.Value{
+		TYPE_NAME_KEY: cty.StringVal(t.TypeName),
+	}
+	if t.Sensitive {
+		attrs[TYPE_SENSITIVE_KEY] = cty.True
+	}
+	switch t.TypeName {
+	case NUMBER_TYPE, BOOLEAN_TYPE, STRING_TYPE, DYNAMIC_TYPE:
+	case OBJECT_TYPE:
+		nested := make(map[string]cty.Value)
+		if t.NestedValues != nil {
+			for name, v := range *t.NestedValues {
+				nestedVal, err := typeToCty(v, path.GetAttr(TYPE_NESTED_VALUES_KEY).GetAttr(name))
+				if err != nil {
+					return cty.NilVal, err
+				}
+				nested[name] = nestedVal
+			}
+		}
+		attrs[TYPE_NESTED_VALUES_KEY] = cty.ObjectVal(nested)
+		if t.OptionalAttrs != nil && len(*t.OptionalAttrs) > 0 {
+			optional := make([]cty.Value, 0, len(*t.OptionalAttrs))
+			for _, name := range *t.OptionalAttrs {
+				optional = append(optional, cty.StringVal(name))
+			}
+			attrs[TYPE_OPTIONAL_ATTRS_KEY] = cty.ListVal(optional)
+		}
+		if t.Defaults != nil && len(*t.Defaults) > 0 {
+			defaults := make(map[string]cty.Value, len(*t.Defaults))
+			for name, data := range *t.Defaults {
+				var attrType Type
+				ok := false
+				if t.NestedValues != nil {
+					attrType, ok = (*t.NestedValues)[name]
+				}
+				if !ok {
+					return cty.NilVal, path.GetAttr(TYPE_DEFAULTS_KEY).GetAttr(name).NewErrorf("default for undeclared attribute %q", name)
+				}
+				defaultVal, err := ctyjson.Unmarshal(data, attrType.ToCty())
+				if err != nil {
+					return cty.NilVal, path.GetAttr(TYPE_DEFAULTS_KEY).GetAttr(name).NewError(err)
+				}
+				defaults[name] = defaultVal
+			}
+			attrs[TYPE_DEFAULTS_KEY] = cty.ObjectVal(defaults)
+		}
+	case LIST_TYPE, SET_TYPE, MAP_TYPE:
+		if t.InternalType == nil {
+			return cty.NilVal, path.GetAttr(TYPE_INTERNAL_TYPE_KEY).NewErrorf("%s types must have an internal type", t.TypeName)
+		}
+		internalVal, err := typeToCty(*t.InternalType, path.GetAttr(TYPE_INTERNAL_TYPE_KEY))
 		if err != nil {
-			return nil, err
+			return cty.NilVal, err
 		}
-		out := Type{
-			TypeName:     val.GetAttr(TYPE_NAME_KEY).AsString(),
-			NestedValues: nil,
-			InternalType: internalType,
+		attrs[TYPE_INTERNAL_TYPE_KEY] = internalVal
+	case TUPLE_TYPE:
+		var elements []cty.Value
+		if t.ElementTypes != nil {
+			for i, v := range *t.ElementTypes {
+				el, err := typeToCty(v, path.GetAttr(TYPE_ELEMENT_TYPES_KEY).IndexInt(i))
+				if err != nil {
+					return cty.NilVal, err
+				}
+				elements = append(elements, el)
+			}
 		}
-		return &out, nil
+		attrs[TYPE_ELEMENT_TYPES_KEY] = cty.TupleVal(elements)
 	default:
-		return &Type{
-			TypeName: val.GetAttr(TYPE_NAME_KEY).AsString(),
-		}, nil
+		return cty.NilVal, path.GetAttr(TYPE_NAME_KEY).NewErrorf("unknown type name %q, which must be one of %s",
+			t.TypeName, strings.Join(typeNames(), ", "))
 	}
-	return nil, nil
+	return cty.ObjectVal(attrs), nil
 }
 
 // ToCty is used for converting types into the cty.Type. The cty library is a hard dep in
@@ -143,7 +377,7 @@ func (t *Type) ToCty() cty.Type {
 		for k, v := range *t.NestedValues {
 			mappedTypes[k] = v.ToCty()
 		}
-		return cty.Object(mappedTypes)
+		return cty.ObjectWithOptionalAttrs(mappedTypes, t.optionalAttrs())
 	case MAP_TYPE:
 		if t.InternalType == nil {
 			return cty.Map(cty.String)
@@ -154,11 +388,82 @@ func (t *Type) ToCty() cty.Type {
 			return cty.List(cty.String)
 		}
 		return cty.List(t.InternalType.ToCty())
+	case SET_TYPE:
+		if t.InternalType == nil {
+			return cty.Set(cty.String)
+		}
+		return cty.Set(t.InternalType.ToCty())
+	case TUPLE_TYPE:
+		if t.ElementTypes == nil {
+			return cty.EmptyTuple
+		}
+		elementTypes := make([]cty.Type, 0, len(*t.ElementTypes))
+		for _, v := range *t.ElementTypes {
+			elementTypes = append(elementTypes, v.ToCty())
+		}
+		return cty.Tuple(elementTypes)
+	case DYNAMIC_TYPE:
+		//values of a dynamic type are encoded in cty JSON alongside their concrete type, so
+		//they can be decoded without knowing the type ahead of time
+		return cty.DynamicPseudoType
 	default:
 		return cty.NilType
 	}
 }
 
+// valueType is the cty.Type of values of t. Values don't have optional attributes, so unlike
+// ToCty it has none.
+func (t *Type) valueType() cty.Type {
+	return t.ToCty().WithoutOptionalAttributesDeep()
+}
+
+// optionalAttrs returns the names of an object's optional attributes, including those with
+// defaults. Names that aren't attributes of the object are left out.
+func (t *Type) optionalAttrs() []string {
+	if t.NestedValues == nil {
+		return nil
+	}
+	var out []string
+	if t.OptionalAttrs != nil {
+		for _, name := range *t.OptionalAttrs {
+			if _, ok := (*t.NestedValues)[name]; ok {
+				out = append(out, name)
+			}
+		}
+	}
+	if t.Defaults != nil {
+		for name := range *t.Defaults {
+			if _, ok := (*t.NestedValues)[name]; ok {
+				out = append(out, name)
+			}
+		}
+	}
+	return out
+}
+
+// setDefaults encodes defaults as cty JSON of their attribute's type and stores them on an object type.
+func (t *Type) setDefaults(defaults map[string]cty.Value) error {
+	encoded := make(map[string]json.RawMessage, len(defaults))
+	for name, val := range defaults {
+		attrType, ok := (*t.NestedValues)[name]
+		if !ok {
+			return fmt.Errorf("default for undeclared attribute %q", name)
+		}
+		ctyType := attrType.ToCty()
+		converted, err := convert.Convert(val, ctyType)
+		if err != nil {
+			return fmt.Errorf("invalid default for attribute %q: %w", name, err)
+		}
+		data, err := ctyjson.Marshal(converted, attrType.valueType())
+		if err != nil {
+			return fmt.Errorf("invalid default for attribute %q: %w", name, err)
+		}
+		encoded[name] = data
+	}
+	t.Defaults = &encoded
+	return nil
+}
+
 // String represents a primitive string type
 var String Type
 
@@ -171,6 +476,8 @@ var Bool Type
 // Dynamic represents a type that can be anything. This is not recommended
 // as these values cannot be validated ahead of time in user configuration.
 // Only use this value when the underlying type is uncertain and variable.
+// Dynamic values are serialized together with their concrete type, so they
+// keep that type when they are decoded on the other side of the wire.
 var Dynamic Type
 
 // Invalid is used as an error value for types
@@ -185,6 +492,27 @@ func Object(data map[string]Type) Type {
 	}
 }
 
+// ObjectWithOptionalAttrs creates an object like Object, except that the named attributes
+// may be omitted. Omitted attributes are null.
+func ObjectWithOptionalAttrs(data map[string]Type, optional ...string) Type {
+	out := Object(data)
+	out.OptionalAttrs = &optional
+	return out
+}
+
+// ObjectWithDefaults creates an object like Object, except that the attributes in defaults
+// may be omitted, and take their default value when they are. Use a null default for an
+// optional attribute that has no default. An error is returned if a default value does not
+// conform to its attribute's type.
+func ObjectWithDefaults(data map[string]Type, defaults map[string]cty.Value) (Type, error) {
+	out := Object(data)
+	err := out.setDefaults(defaults)
+	if err != nil {
+		return Invalid, err
+	}
+	return out, nil
+}
+
 // Map creates a map with unknown keys but known values as a Type object.
 // Use this when the map keys are unknown/variable, but values are known
 func Map(valType Type) Type {
@@ -202,6 +530,27 @@ func List(valType Type) Type {
 	}
 }
 
+// Set creates a set of unique values of a known type. Sets are unordered, so use a List
+// when the order of the values matters.
+func Set(valType Type) Type {
+	return Type{
+		TypeName:     SET_TYPE,
+		InternalType: &valType,
+	}
+}
+
+// Tuple creates a fixed length list whose values each have their own type, in order.
+// Use this for heterogeneous arrays, where a List would require every value to have the same type.
+func Tuple(elementTypes ...Type) Type {
+	if elementTypes == nil {
+		elementTypes = []Type{}
+	}
+	return Type{
+		TypeName:     TUPLE_TYPE,
+		ElementTypes: &elementTypes,
+	}
+}
+
 func init() {
 	String = Type{
 		TypeName: STRING_TYPE,
@@ -0,0 +1,161 @@
+package sbsdk
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestTypeDescriptionRoundTrip(t *testing.T) {
+	sensitiveString := String
+	sensitiveString.Sensitive = true
+	withDefaults, err := ObjectWithDefaults(map[string]Type{
+		"name":  String,
+		"count": Number,
+		"tags":  List(String),
+	}, map[string]cty.Value{
+		"count": cty.NumberIntVal(3),
+		"tags":  cty.ListVal([]cty.Value{cty.StringVal("a")}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		typ  Type
+	}{
+		{name: "string", typ: String},
+		{name: "number", typ: Number},
+		{name: "bool", typ: Bool},
+		{name: "dynamic", typ: Dynamic},
+		{name: "sensitive string", typ: sensitiveString},
+		{name: "list", typ: List(String)},
+		{name: "set", typ: Set(Number)},
+		{name: "map", typ: Map(Bool)},
+		{name: "nested list", typ: List(Map(sensitiveString))},
+		{name: "tuple", typ: Tuple(String, Number, List(Bool))},
+		{name: "empty tuple", typ: Tuple()},
+		{name: "object", typ: Object(map[string]Type{"name": String, "enabled": Bool})},
+		{name: "empty object", typ: Object(map[string]Type{})},
+		{name: "object with optional attrs", typ: ObjectWithOptionalAttrs(map[string]Type{
+			"name":    String,
+			"comment": String,
+			"size":    Number,
+		}, "comment", "size")},
+		{name: "object with defaults", typ: withDefaults},
+		{name: "object with sensitive attrs", typ: Object(map[string]Type{
+			"user":     String,
+			"password": sensitiveString,
+		})},
+		{name: "nested objects", typ: Object(map[string]Type{
+			"headers": Map(String),
+			"items": List(ObjectWithOptionalAttrs(map[string]Type{
+				"id":   Number,
+				"note": String,
+			}, "note")),
+			"pair": Tuple(Object(map[string]Type{"a": String}), Set(Dynamic)),
+		})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := FromTypeToCty(tt.typ)
+			if err != nil {
+				t.Fatalf("FromTypeToCty: %s", err)
+			}
+			got, err := FromCtyToType(val, false)
+			if err != nil {
+				t.Fatalf("FromCtyToType: %s", err)
+			}
+			if !reflect.DeepEqual(*got, tt.typ) {
+				t.Fatalf("round trip changed the type\n got: %s\nwant: %s", typeJSON(t, *got), typeJSON(t, tt.typ))
+			}
+			if !got.ToCty().Equals(tt.typ.ToCty()) {
+				t.Fatalf("got cty type %#v, want %#v", got.ToCty(), tt.typ.ToCty())
+			}
+		})
+	}
+}
+
+func TestFromCtyToTypeRejectsInvalidDescriptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		val    cty.Value
+		isRoot bool
+		path   cty.Path
+	}{
+		{
+			name: "unknown type name",
+			val:  cty.ObjectVal(map[string]cty.Value{TYPE_NAME_KEY: cty.StringVal("strnig")}),
+			path: cty.GetAttrPath(TYPE_NAME_KEY),
+		},
+		{
+			name: "nested unknown type name",
+			val: cty.ObjectVal(map[string]cty.Value{
+				TYPE_NAME_KEY: cty.StringVal(OBJECT_TYPE),
+				TYPE_NESTED_VALUES_KEY: cty.ObjectVal(map[string]cty.Value{
+					"name": cty.ObjectVal(map[string]cty.Value{TYPE_NAME_KEY: cty.StringVal("strnig")}),
+				}),
+			}),
+			path: cty.GetAttrPath(TYPE_NESTED_VALUES_KEY).GetAttr("name").GetAttr(TYPE_NAME_KEY),
+		},
+		{
+			name: "list without internal_type",
+			val:  cty.ObjectVal(map[string]cty.Value{TYPE_NAME_KEY: cty.StringVal(LIST_TYPE)}),
+			path: cty.GetAttrPath(TYPE_INTERNAL_TYPE_KEY),
+		},
+		{
+			name:   "root that isn't an object",
+			val:    cty.ObjectVal(map[string]cty.Value{TYPE_NAME_KEY: cty.StringVal(STRING_TYPE)}),
+			isRoot: true,
+			path:   cty.GetAttrPath(TYPE_NAME_KEY),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromCtyToType(tt.val, tt.isRoot)
+			if err == nil {
+				t.Fatalf("got type %s, want an error", typeJSON(t, *got))
+			}
+			assertDiagnosticPath(t, err, tt.path)
+		})
+	}
+}
+
+func TestFromTypeToCtyRejectsInvalidTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  Type
+		path cty.Path
+	}{
+		{
+			name: "unknown type name",
+			typ:  Type{TypeName: "strnig"},
+			path: cty.GetAttrPath(TYPE_NAME_KEY),
+		},
+		{
+			name: "list without internal type",
+			typ:  Type{TypeName: LIST_TYPE},
+			path: cty.GetAttrPath(TYPE_INTERNAL_TYPE_KEY),
+		},
+		{
+			name: "defaults without nested values",
+			typ: Type{
+				TypeName: OBJECT_TYPE,
+				Defaults: &map[string]json.RawMessage{"name": json.RawMessage(`"a"`)},
+			},
+			path: cty.GetAttrPath(TYPE_DEFAULTS_KEY).GetAttr("name"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FromTypeToCty(tt.typ)
+			if err == nil {
+				t.Fatal("got no error")
+			}
+			assertDiagnosticPath(t, err, tt.path)
+		})
+	}
+}
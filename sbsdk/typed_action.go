@@ -0,0 +1,68 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// TypedAction is an Action implemented by a function of native Go values. Its
+// ConfigurationSchema and OutputType are derived from In and Out with SchemaFor and TypeFor,
+// and its input and output are converted with Decode and Encode, so the function never sees a
+// cty.Value unless In or Out has cty.Value fields.
+type TypedAction[In, Out any] struct {
+	//Func is called by Evaluate with the decoded input. Errors it returns are passed to the
+	//runner unchanged, so they may be classified with Retryable, Permanent and friends.
+	Func func(ctx context.Context, contextId string, in In) (Out, error)
+	//Doc is returned from Metadata
+	Doc Metadata
+}
+
+// NewTypedAction creates a TypedAction from fn, with In and Out inferred from its signature.
+func NewTypedAction[In, Out any](fn func(ctx context.Context, contextId string, in In) (Out, error)) *TypedAction[In, Out] {
+	return &TypedAction[In, Out]{Func: fn}
+}
+
+func (a *TypedAction[In, Out]) ConfigurationSchema() (ObjectSchema, error) {
+	return SchemaFor[In]()
+}
+
+func (a *TypedAction[In, Out]) OutputType() (Type, error) {
+	return TypeFor[Out]()
+}
+
+func (a *TypedAction[In, Out]) Metadata() Metadata {
+	return a.Doc
+}
+
+// Evaluate decodes input into an In, calls Func and encodes its result. Input that can't be
+// decoded fails permanently, since retrying the same input can't help.
+func (a *TypedAction[In, Out]) Evaluate(ctx context.Context, contextId string, input cty.Value) (cty.Value, error) {
+	in, err := Decode[In](input)
+	if err != nil {
+		return cty.NilVal, permanentDiagnostics(err)
+	}
+	out, err := a.Func(ctx, contextId, in)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return Encode(out)
+}
+
+// permanentDiagnostics classifies every error diagnostic in err as ErrorKindPermanent, keeping
+// their attribute paths. Errors that aren't Diagnostics are wrapped with Permanent.
+func permanentDiagnostics(err error) error {
+	var diags Diagnostics
+	if !errors.As(err, &diags) {
+		return Permanent(err)
+	}
+	out := make(Diagnostics, 0, len(diags))
+	for _, diag := range diags {
+		if diag.Severity == DiagError {
+			diag.Kind = ErrorKindPermanent
+		}
+		out = append(out, diag)
+	}
+	return out
+}
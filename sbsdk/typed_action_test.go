@@ -0,0 +1,88 @@
+package sbsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+type greetInput struct {
+	Name  string `sb:"name,required"`
+	Times int    `sb:"times"`
+}
+
+type greetOutput struct {
+	Greeting string `sb:"greeting,required"`
+	Token    string `sb:"token,sensitive"`
+}
+
+func greet(_ context.Context, contextId string, in greetInput) (greetOutput, error) {
+	return greetOutput{Greeting: fmt.Sprintf("hello %s x%d from %s", in.Name, in.Times, contextId), Token: "t"}, nil
+}
+
+func TestTypedAction(t *testing.T) {
+	provider := NewProvider(WithAction("greet", NewTypedAction(greet)))
+	ctx := context.Background()
+	output, err := provider.ActionEvaluate(ctx, "ctx", "greet", []byte(`{"name":"ada","times":2}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputType, err := provider.ActionOutputType(ctx, "greet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	val, err := output.Decode(outputType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Decode[greetOutput](val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Greeting != "hello ada x2 from ctx" {
+		t.Errorf("got greeting %q", got.Greeting)
+	}
+	if !IsSensitive(val.GetAttr("token")) {
+		t.Errorf("got token %#v, want it marked sensitive", val.GetAttr("token"))
+	}
+}
+
+func TestTypedActionInputErrorsArePermanent(t *testing.T) {
+	action := NewTypedAction(greet)
+	input := cty.ObjectVal(map[string]cty.Value{
+		"name":  cty.StringVal("ada"),
+		"times": cty.NumberFloatVal(1.5),
+	})
+	_, err := action.Evaluate(context.Background(), "ctx", input)
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if ErrorKindOf(err) != ErrorKindPermanent {
+		t.Errorf("got kind %s, want %s", ErrorKindOf(err), ErrorKindPermanent)
+	}
+	assertDiagnosticPath(t, err, cty.GetAttrPath("times"))
+}
+
+func TestTypedActionErrorsArePassedThrough(t *testing.T) {
+	errVendor := errors.New("vendor is down")
+	action := NewTypedAction(func(_ context.Context, _ string, _ greetInput) (greetOutput, error) {
+		return greetOutput{}, Retryable(fmt.Errorf("greeting: %w", errVendor), time.Second)
+	})
+	_, err := action.Evaluate(context.Background(), "ctx", cty.ObjectVal(map[string]cty.Value{
+		"name":  cty.StringVal("ada"),
+		"times": cty.NullVal(cty.Number),
+	}))
+	if !errors.Is(err, errVendor) {
+		t.Errorf("got error %v, want one wrapping %v", err, errVendor)
+	}
+	if ErrorKindOf(err) != ErrorKindRetryable {
+		t.Errorf("got kind %s, want %s", ErrorKindOf(err), ErrorKindRetryable)
+	}
+	if retryAfter, _ := RetryAfterOf(err); retryAfter != time.Second {
+		t.Errorf("got retry after %s, want %s", retryAfter, time.Second)
+	}
+}
@@ -0,0 +1,232 @@
+package sbsdk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// ParseTypeExpr parses a Terraform-style type expression, such as `map(number)` or
+// `object({ id = string, tags = optional(list(string), []) })`, into a Type. `any` is Dynamic,
+// and optional object attributes become OptionalAttrs, or Defaults when they have a default.
+// Invalid expressions are rejected with Diagnostics pointing at the offending part of expr.
+// Type.String is the reverse conversion.
+func ParseTypeExpr(expr hcl.Expression) (Type, error) {
+	ty, defaults, diags := typeexpr.TypeConstraintWithDefaults(expr)
+	if diags.HasErrors() {
+		return Invalid, DiagnosticsFromHCL(diags)
+	}
+	out, err := typeFromCtyType(ty, defaults)
+	if err != nil {
+		return Invalid, Diagnostics{{
+			Severity: DiagError,
+			Summary:  "Invalid type specification",
+			Detail:   err.Error(),
+			Subject:  expr.Range().Ptr(),
+		}}
+	}
+	return out, nil
+}
+
+// typeFromCtyType converts a cty.Type, and the defaults parsed alongside it by typeexpr, into a Type.
+func typeFromCtyType(ty cty.Type, defaults *typeexpr.Defaults) (Type, error) {
+	child := func(key string) *typeexpr.Defaults {
+		if defaults == nil {
+			return nil
+		}
+		return defaults.Children[key]
+	}
+	switch {
+	case ty == cty.DynamicPseudoType:
+		return Dynamic, nil
+	case ty == cty.String:
+		return String, nil
+	case ty == cty.Number:
+		return Number, nil
+	case ty == cty.Bool:
+		return Bool, nil
+	case ty.IsListType(), ty.IsSetType(), ty.IsMapType():
+		elementType, err := typeFromCtyType(ty.ElementType(), child(""))
+		if err != nil {
+			return Invalid, err
+		}
+		switch {
+		case ty.IsListType():
+			return List(elementType), nil
+		case ty.IsSetType():
+			return Set(elementType), nil
+		default:
+			return Map(elementType), nil
+		}
+	case ty.IsTupleType():
+		elementTypes := make([]Type, 0, len(ty.TupleElementTypes()))
+		for i, elementType := range ty.TupleElementTypes() {
+			el, err := typeFromCtyType(elementType, child(strconv.Itoa(i)))
+			if err != nil {
+				return Invalid, err
+			}
+			elementTypes = append(elementTypes, el)
+		}
+		return Tuple(elementTypes...), nil
+	case ty.IsObjectType():
+		attrs := make(map[string]Type, len(ty.AttributeTypes()))
+		for name, attrType := range ty.AttributeTypes() {
+			attr, err := typeFromCtyType(attrType, child(name))
+			if err != nil {
+				return Invalid, err
+			}
+			attrs[name] = attr
+		}
+		out := Object(attrs)
+		var defaultValues map[string]cty.Value
+		if defaults != nil {
+			defaultValues = defaults.DefaultValues
+		}
+		var optional []string
+		for name := range ty.OptionalAttributes() {
+			if _, ok := defaultValues[name]; !ok {
+				optional = append(optional, name)
+			}
+		}
+		if len(optional) > 0 {
+			sort.Strings(optional)
+			out.OptionalAttrs = &optional
+		}
+		if len(defaultValues) > 0 {
+			err := out.setDefaults(defaultValues)
+			if err != nil {
+				return Invalid, err
+			}
+		}
+		return out, nil
+	default:
+		return Invalid, fmt.Errorf("%s is not supported", ty.FriendlyName())
+	}
+}
+
+// String renders t as a type expression that ParseTypeExpr parses back into an equivalent Type.
+// Sensitive and Metadata can't be expressed, and are left out. It has a value receiver so that
+// Type values, and not only pointers, print as type expressions with fmt.
+func (t Type) String() string {
+	var b strings.Builder
+	t.writeTypeExpr(&b)
+	return b.String()
+}
+
+func (t *Type) writeTypeExpr(b *strings.Builder) {
+	switch t.TypeName {
+	case DYNAMIC_TYPE:
+		b.WriteString("any")
+	case LIST_TYPE, SET_TYPE, MAP_TYPE:
+		b.WriteString(t.TypeName)
+		b.WriteByte('(')
+		if t.InternalType == nil {
+			b.WriteString(STRING_TYPE)
+		} else {
+			t.InternalType.writeTypeExpr(b)
+		}
+		b.WriteByte(')')
+	case TUPLE_TYPE:
+		b.WriteString("tuple([")
+		if t.ElementTypes != nil {
+			for i, v := range *t.ElementTypes {
+				if i > 0 {
+					b.WriteString(", ")
+				}
+				v.writeTypeExpr(b)
+			}
+		}
+		b.WriteString("])")
+	case OBJECT_TYPE:
+		b.WriteString("object({")
+		if t.NestedValues != nil {
+			names := make([]string, 0, len(*t.NestedValues))
+			for name := range *t.NestedValues {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			optional := t.optionalAttrs()
+			for i, name := range names {
+				if i > 0 {
+					b.WriteString(", ")
+				}
+				attrType := (*t.NestedValues)[name]
+				writeObjectKey(b, name)
+				b.WriteString(" = ")
+				if !containsString(optional, name) {
+					attrType.writeTypeExpr(b)
+					continue
+				}
+				b.WriteString("optional(")
+				attrType.writeTypeExpr(b)
+				if t.Defaults != nil {
+					if data, ok := (*t.Defaults)[name]; ok {
+						if val, err := ctyjson.Unmarshal(data, attrType.valueType()); err == nil {
+							b.WriteString(", ")
+							writeLiteral(b, val)
+						}
+					}
+				}
+				b.WriteByte(')')
+			}
+		}
+		b.WriteString("})")
+	default:
+		b.WriteString(t.TypeName)
+	}
+}
+
+// writeObjectKey writes an object key, quoting it unless it's a valid identifier. typeexpr only
+// accepts identifiers, so a Type with other attribute names renders as a literal that
+// ParseTypeExpr rejects.
+func writeObjectKey(b *strings.Builder, key string) {
+	if hclsyntax.ValidIdentifier(key) {
+		b.WriteString(key)
+		return
+	}
+	b.Write(hclwrite.TokensForValue(cty.StringVal(key)).Bytes())
+}
+
+// writeLiteral writes val as an hcl literal on a single line.
+func writeLiteral(b *strings.Builder, val cty.Value) {
+	switch {
+	case val.IsNull() || !val.Type().IsCollectionType() && !val.Type().IsObjectType() && !val.Type().IsTupleType():
+		b.Write(hclwrite.TokensForValue(val).Bytes())
+	case val.Type().IsObjectType() || val.Type().IsMapType():
+		elements := val.AsValueMap()
+		keys := make([]string, 0, len(elements))
+		for k := range elements {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeObjectKey(b, k)
+			b.WriteString(" = ")
+			writeLiteral(b, elements[k])
+		}
+		b.WriteByte('}')
+	default:
+		b.WriteByte('[')
+		iter := val.ElementIterator()
+		for i := 0; iter.Next(); i++ {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			_, el := iter.Element()
+			writeLiteral(b, el)
+		}
+		b.WriteByte(']')
+	}
+}
@@ -0,0 +1,63 @@
+package sbsdk
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func parseTypeExprString(t *testing.T, src string) (Type, error) {
+	t.Helper()
+	expr, diags := hclsyntax.ParseExpression([]byte(src), "type.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+	return ParseTypeExpr(expr)
+}
+
+func TestTypeExprRoundTrip(t *testing.T) {
+	exprs := []string{
+		"string",
+		"number",
+		"bool",
+		"any",
+		"list(string)",
+		"set(number)",
+		"map(list(bool))",
+		"tuple([string, number])",
+		"object({id = string, tags = optional(list(string))})",
+		`object({retries = optional(number, 3), user = optional(object({name = string}), {name = "ada"})})`,
+	}
+	for _, src := range exprs {
+		t.Run(src, func(t *testing.T) {
+			typ, err := parseTypeExprString(t, src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := typ.String(); got != src {
+				t.Errorf("got %s, want %s", got, src)
+			}
+			if got := fmt.Sprint(typ); got != src {
+				t.Errorf("got %s from fmt, want %s", got, src)
+			}
+		})
+	}
+}
+
+func TestParseTypeExprErrors(t *testing.T) {
+	for _, src := range []string{"strin", "list(string, number)", "optional(string)"} {
+		t.Run(src, func(t *testing.T) {
+			_, err := parseTypeExprString(t, src)
+			var diags Diagnostics
+			if !errors.As(err, &diags) || !diags.HasErrors() {
+				t.Fatalf("got %v, want diagnostics", err)
+			}
+			if diags[0].Subject == nil || diags[0].Subject.Filename != "type.hcl" {
+				t.Errorf("got diagnostic without a subject: %#v", diags[0])
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package sbsdk
 import (
 	"github.com/hashicorp/hcl/v2/hcldec"
 	"github.com/zclconf/go-cty/cty"
-	"github.com/zclconf/go-cty/cty/json"
 )
 
 func OptionalAttrSchema(name string, valType Type) *AttrSchema {
@@ -30,15 +29,106 @@ func OptionalBlockSchema(name string, nested Schema) *BlockSchema {
 	}
 }
 
+// ListBlockSchema accepts any number of blocks of the type, decoded in order into a list.
+// minItems and maxItems bound the number of blocks, and zero means no bound.
+func ListBlockSchema(name string, nested Schema, minItems int, maxItems int) *BlockSchema {
+	return &BlockSchema{
+		Name:     name,
+		Nested:   nested,
+		Mode:     BLOCK_MODE_LIST,
+		MinItems: minItems,
+		MaxItems: maxItems,
+	}
+}
+
+// SetBlockSchema accepts any number of blocks of the type, decoded into a set so that identical
+// blocks are only kept once. minItems and maxItems bound the number of blocks, and zero means no bound.
+func SetBlockSchema(name string, nested Schema, minItems int, maxItems int) *BlockSchema {
+	return &BlockSchema{
+		Name:     name,
+		Nested:   nested,
+		Mode:     BLOCK_MODE_SET,
+		MinItems: minItems,
+		MaxItems: maxItems,
+	}
+}
+
+// MapBlockSchema accepts any number of labeled blocks of the type, decoded into a map keyed by
+// their labels. There must be at least one label, or CheckSchema reports the block.
+func MapBlockSchema(name string, nested Schema, labels ...string) *BlockSchema {
+	return &BlockSchema{
+		Name:   name,
+		Nested: nested,
+		Mode:   BLOCK_MODE_MAP,
+		Labels: labels,
+	}
+}
+
+// MapInputToCtyValue decodes input that should conform to schema, and checks it against the
+// schema's validators.
 func MapInputToCtyValue(input []byte, schema ObjectSchema) (cty.Value, error) {
-	inputVal, err := json.Unmarshal(input, hcldec.ImpliedType(schema.Decode()))
+	if diags := CheckSchema(&schema); diags.HasErrors() {
+		return cty.NilVal, diags
+	}
+	inputVal, err := unmarshalValue(input, hcldec.ImpliedType(schema.Decode()), impliedType(&schema))
 	if err != nil {
-		return cty.NilVal, err
+		return cty.NilVal, valueDiagnostics("Invalid configuration", err)
+	}
+	diags := ValidateConfig(&schema, inputVal)
+	if diags.HasErrors() {
+		return cty.NilVal, diags
 	}
 	return inputVal, nil
 }
 
+// MapByteStringToCtyValue decodes the output of an action or trigger, encoded by
+// MapCtyValueToByteString, back into a value of outputType, with its sensitive marks applied.
+func MapByteStringToCtyValue(data []byte, outputType Type) (cty.Value, error) {
+	val, err := unmarshalValue(data, outputType.ToCty(), outputType)
+	if err != nil {
+		return cty.NilVal, valueDiagnostics("Value does not conform to its type", err)
+	}
+	return val, nil
+}
+
+// Decode decodes the output of an action into a value of outputType, marked sensitive wherever
+// outputType or SensitivePaths say so.
+func (o ActionOutput) Decode(outputType Type) (cty.Value, error) {
+	val, err := MapByteStringToCtyValue(o.Value, outputType)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	pvm := make([]cty.PathValueMarks, 0, len(o.SensitivePaths))
+	for _, path := range o.SensitivePaths {
+		pvm = append(pvm, cty.PathValueMarks{Path: path, Marks: cty.NewValueMarks(SENSITIVE_MARK)})
+	}
+	return val.MarkWithPaths(pvm), nil
+}
+
+// mapCtyValueToActionOutput encodes the output of an action like MapCtyValueToByteString, and
+// records the paths of its sensitive values.
+func mapCtyValueToActionOutput(val cty.Value, outputType Type) (ActionOutput, error) {
+	out, err := MapCtyValueToByteString(val, outputType)
+	if err != nil {
+		return ActionOutput{}, err
+	}
+	return ActionOutput{Value: out, SensitivePaths: sensitivePaths(val)}, nil
+}
+
 func MapCtyValueToByteString(val cty.Value, outputType Type) ([]byte, error) {
 	ctyType := outputType.ToCty()
-	return json.Marshal(val, ctyType)
+	out, err := marshalValue(val, ctyType, outputType)
+	if err != nil {
+		return nil, valueDiagnostics("Value does not conform to its type", err)
+	}
+	return out, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
 }
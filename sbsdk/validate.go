@@ -0,0 +1,405 @@
+package sbsdk
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+const (
+	VALIDATOR_ENUM   = "enum"
+	VALIDATOR_REGEX  = "regex"
+	VALIDATOR_RANGE  = "range"
+	VALIDATOR_LENGTH = "length"
+	VALIDATOR_FORMAT = "format"
+)
+
+const (
+	FORMAT_URL       = "url"
+	FORMAT_EMAIL     = "email"
+	FORMAT_UUID      = "uuid"
+	FORMAT_DATE      = "date"
+	FORMAT_DATE_TIME = "date-time"
+	FORMAT_IPV4      = "ipv4"
+	FORMAT_IPV6      = "ipv6"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// compiledPatterns caches the compiled VALIDATOR_REGEX patterns by their source, so that each
+// pattern is compiled once however many values it checks, including patterns of validators
+// decoded from a provider's schema rather than created with RegexValidator.
+var compiledPatterns sync.Map
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := compiledPatterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	compiledPatterns.Store(pattern, re)
+	return re, nil
+}
+
+// Validator is a serializable description of a check on an attribute's value, so that the
+// runner and CLI can validate configuration against the schema a provider returns, without
+// calling the provider. Create one with EnumValidator, RegexValidator, RangeValidator,
+// LengthValidator or FormatValidator.
+type Validator struct {
+	//Kind is one of the VALIDATOR constants, and decides which of the other fields are used
+	Kind string `json:"kind"`
+	//Values are the permitted values of a VALIDATOR_ENUM, compared as strings
+	Values []string `json:"values,omitempty"`
+	//Pattern is the regular expression, in Go syntax, that a VALIDATOR_REGEX value must match
+	Pattern string `json:"pattern,omitempty"`
+	//Min and Max are the inclusive bounds of a VALIDATOR_RANGE number, or of the number of characters
+	//or elements for VALIDATOR_LENGTH. A nil bound is not checked.
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	//Format is one of the FORMAT constants, and is used by VALIDATOR_FORMAT
+	Format string `json:"format,omitempty"`
+}
+
+// EnumValidator permits only the given values. Number and bool attributes are compared
+// by their string representation, such as "10" or "true".
+func EnumValidator(values ...string) Validator {
+	return Validator{
+		Kind:   VALIDATOR_ENUM,
+		Values: values,
+	}
+}
+
+// RegexValidator requires a string to match pattern. Anchor the pattern with ^ and $ to
+// match the whole string. The pattern is compiled here, once, and CheckSchema reports it if
+// it doesn't compile.
+func RegexValidator(pattern string) Validator {
+	_, _ = compilePattern(pattern)
+	return Validator{
+		Kind:    VALIDATOR_REGEX,
+		Pattern: pattern,
+	}
+}
+
+// RangeValidator requires a number to be between min and max, inclusive.
+func RangeValidator(min float64, max float64) Validator {
+	return Validator{
+		Kind: VALIDATOR_RANGE,
+		Min:  &min,
+		Max:  &max,
+	}
+}
+
+// LengthValidator requires a string to have between min and max characters, or a collection
+// to have between min and max elements, inclusive.
+func LengthValidator(min int, max int) Validator {
+	minFloat := float64(min)
+	maxFloat := float64(max)
+	return Validator{
+		Kind: VALIDATOR_LENGTH,
+		Min:  &minFloat,
+		Max:  &maxFloat,
+	}
+}
+
+// FormatValidator requires a string to be in one of the well-known FORMAT formats.
+func FormatValidator(format string) Validator {
+	return Validator{
+		Kind:   VALIDATOR_FORMAT,
+		Format: format,
+	}
+}
+
+// ValidateConfig checks a decoded configuration value against the validators and constraints
+// declared in schema, and returns a Diagnostic pointing at each offending attribute. Attributes
+// and blocks marked Deprecated that are set produce warnings. Required blocks in BLOCK_MODE_MAP,
+// which hcldec can't require, are checked here too.
+func ValidateConfig(schema Schema, val cty.Value) Diagnostics {
+	//validators never include values in their diagnostics, so sensitive values can be unmarked
+	val, _ = val.UnmarkDeep()
+	return validateSchema(schema, val, cty.Path{})
+}
+
+func validateSchema(schema Schema, val cty.Value, path cty.Path) Diagnostics {
+	if !val.IsKnown() {
+		return nil
+	}
+	if block, ok := schema.(*BlockSchema); ok && block.Mode == BLOCK_MODE_MAP && block.Required && (val.IsNull() || val.LengthInt() == 0) {
+		return Diagnostics{{
+			Severity:  DiagError,
+			Summary:   "Missing required block",
+			Detail:    fmt.Sprintf("At least one %q block is required.", block.Name),
+			Attribute: path,
+		}}
+	}
+	if val.IsNull() {
+		return nil
+	}
+	var diags Diagnostics
+	switch s := schema.(type) {
+	case *ObjectSchema:
+		if !val.Type().IsObjectType() {
+			return nil
+		}
+		keys := make([]string, 0, len(s.Attributes))
+		for k := range s.Attributes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if !val.Type().HasAttribute(k) {
+				continue
+			}
+			diags = append(diags, validateSchema(s.Attributes[k], val.GetAttr(k), path.GetAttr(k))...)
+		}
+		diags = append(diags, validateConstraints(s, val, path)...)
+	case *BlockSchema:
+		if s.Deprecated && (s.Mode == BLOCK_MODE_SINGLE || s.Mode == "" || val.LengthInt() > 0) {
+			diags = append(diags, deprecationWarning("block", s.Name, s.Metadata, path))
+		}
+		switch s.Mode {
+		case BLOCK_MODE_LIST, BLOCK_MODE_SET:
+			iter := val.ElementIterator()
+			for iter.Next() {
+				k, v := iter.Element()
+				elementPath := path
+				if s.Mode == BLOCK_MODE_LIST {
+					elementPath = path.Index(k)
+				}
+				diags = append(diags, validateSchema(s.Nested, v, elementPath)...)
+			}
+		case BLOCK_MODE_MAP:
+			diags = append(diags, validateBlockMap(s.Nested, val, path, len(s.Labels))...)
+		default:
+			diags = append(diags, validateSchema(s.Nested, val, path)...)
+		}
+	case *AttrSchema:
+		if s.Deprecated {
+			diags = append(diags, deprecationWarning("attribute", s.Name, s.Metadata, path))
+		}
+		for _, validator := range s.Validators {
+			diags = append(diags, validator.validate(val, path)...)
+		}
+	}
+	return diags
+}
+
+// deprecationWarning warns that configuration uses a deprecated attribute or block.
+func deprecationWarning(kind string, name string, metadata Metadata, path cty.Path) Diagnostic {
+	detail := metadata.DeprecationMessage
+	if detail == "" {
+		detail = fmt.Sprintf("The %s %q is deprecated and will be removed in a future version of the provider.", kind, name)
+	}
+	return Diagnostic{
+		Severity:  DiagWarning,
+		Summary:   fmt.Sprintf("Deprecated %s", kind),
+		Detail:    detail,
+		Attribute: path,
+	}
+}
+
+// validateBlockMap validates the blocks of a BLOCK_MODE_MAP block, which are nested one map
+// deep per label.
+func validateBlockMap(nested Schema, val cty.Value, path cty.Path, depth int) Diagnostics {
+	if depth == 0 {
+		return validateSchema(nested, val, path)
+	}
+	if val.IsNull() || !val.IsKnown() {
+		return nil
+	}
+	var diags Diagnostics
+	iter := val.ElementIterator()
+	for iter.Next() {
+		k, v := iter.Element()
+		diags = append(diags, validateBlockMap(nested, v, path.Index(k), depth-1)...)
+	}
+	return diags
+}
+
+func (v *Validator) validate(val cty.Value, path cty.Path) Diagnostics {
+	if val.IsNull() || !val.IsKnown() {
+		return nil
+	}
+	switch v.Kind {
+	case VALIDATOR_ENUM:
+		str, ok := validatorString(val)
+		if !ok {
+			return v.invalid(path, "Validator requires a string, number or bool value")
+		}
+		for _, allowed := range v.Values {
+			if str == allowed {
+				return nil
+			}
+		}
+		quoted := make([]string, 0, len(v.Values))
+		for _, allowed := range v.Values {
+			quoted = append(quoted, fmt.Sprintf("%q", allowed))
+		}
+		return invalidValue(path, fmt.Sprintf("Must be one of %s.", strings.Join(quoted, ", ")))
+	case VALIDATOR_REGEX:
+		str, ok := validatorString(val)
+		if !ok {
+			return v.invalid(path, "Validator requires a string value")
+		}
+		re, err := compilePattern(v.Pattern)
+		if err != nil {
+			return v.invalid(path, fmt.Sprintf("Invalid pattern: %s", err))
+		}
+		if !re.MatchString(str) {
+			return invalidValue(path, fmt.Sprintf("Must match the pattern %q.", v.Pattern))
+		}
+	case VALIDATOR_RANGE:
+		if !val.Type().Equals(cty.Number) {
+			return v.invalid(path, "Validator requires a number value")
+		}
+		if !v.inBounds(val.AsBigFloat()) {
+			return invalidValue(path, fmt.Sprintf("Must be %s.", v.describeBounds("")))
+		}
+	case VALIDATOR_LENGTH:
+		var length int
+		switch {
+		case val.Type().Equals(cty.String):
+			length = utf8.RuneCountInString(val.AsString())
+		case val.CanIterateElements():
+			length = val.LengthInt()
+		default:
+			return v.invalid(path, "Validator requires a string or collection value")
+		}
+		if !v.inBounds(big.NewFloat(float64(length))) {
+			unit := " characters"
+			if !val.Type().Equals(cty.String) {
+				unit = " elements"
+			}
+			return invalidValue(path, fmt.Sprintf("Must have %s.", v.describeBounds(unit)))
+		}
+	case VALIDATOR_FORMAT:
+		str, ok := validatorString(val)
+		if !ok {
+			return v.invalid(path, "Validator requires a string value")
+		}
+		valid, known := matchesFormat(v.Format, str)
+		if !known {
+			return v.invalid(path, fmt.Sprintf("Unsupported format %q.", v.Format))
+		}
+		if !valid {
+			return invalidValue(path, fmt.Sprintf("Must be a valid %s.", v.Format))
+		}
+	default:
+		return v.invalid(path, fmt.Sprintf("Unsupported validator kind %q.", v.Kind))
+	}
+	return nil
+}
+
+// check reports a validator that can't be applied to any value, such as a VALIDATOR_REGEX
+// whose pattern doesn't compile, so that CheckSchema catches it before any configuration does.
+func (v *Validator) check(path cty.Path) Diagnostics {
+	switch v.Kind {
+	case VALIDATOR_ENUM, VALIDATOR_RANGE, VALIDATOR_LENGTH:
+	case VALIDATOR_REGEX:
+		if _, err := compilePattern(v.Pattern); err != nil {
+			return v.invalid(path, fmt.Sprintf("Invalid pattern: %s", err))
+		}
+	case VALIDATOR_FORMAT:
+		if _, known := matchesFormat(v.Format, ""); !known {
+			return v.invalid(path, fmt.Sprintf("Unsupported format %q.", v.Format))
+		}
+	default:
+		return v.invalid(path, fmt.Sprintf("Unsupported validator kind %q.", v.Kind))
+	}
+	return nil
+}
+
+func (v *Validator) inBounds(n *big.Float) bool {
+	if v.Min != nil && n.Cmp(big.NewFloat(*v.Min)) < 0 {
+		return false
+	}
+	if v.Max != nil && n.Cmp(big.NewFloat(*v.Max)) > 0 {
+		return false
+	}
+	return true
+}
+
+func (v *Validator) describeBounds(unit string) string {
+	switch {
+	case v.Min != nil && v.Max != nil:
+		return fmt.Sprintf("between %g and %g%s", *v.Min, *v.Max, unit)
+	case v.Min != nil:
+		return fmt.Sprintf("at least %g%s", *v.Min, unit)
+	case v.Max != nil:
+		return fmt.Sprintf("at most %g%s", *v.Max, unit)
+	default:
+		return "any" + unit
+	}
+}
+
+// invalid reports a validator that can't be applied, which is a bug in the provider's schema
+// rather than in the user's configuration.
+func (v *Validator) invalid(path cty.Path, detail string) Diagnostics {
+	return Diagnostics{{
+		Severity:  DiagError,
+		Summary:   fmt.Sprintf("Invalid %s validator", v.Kind),
+		Detail:    detail + " This is a bug in the provider's schema.",
+		Attribute: path,
+	}}
+}
+
+func invalidValue(path cty.Path, detail string) Diagnostics {
+	return Diagnostics{{
+		Severity:  DiagError,
+		Summary:   "Invalid value for attribute",
+		Detail:    detail,
+		Attribute: path,
+	}}
+}
+
+// validatorString returns the string representation of a primitive value.
+func validatorString(val cty.Value) (string, bool) {
+	if !val.Type().IsPrimitiveType() {
+		return "", false
+	}
+	str, err := convert.Convert(val, cty.String)
+	if err != nil {
+		return "", false
+	}
+	return str.AsString(), true
+}
+
+// matchesFormat reports whether str is in the given format, and whether the format is known.
+func matchesFormat(format string, str string) (bool, bool) {
+	switch format {
+	case FORMAT_URL:
+		u, err := url.Parse(str)
+		return err == nil && u.Scheme != "" && u.Host != "", true
+	case FORMAT_EMAIL:
+		addr, err := mail.ParseAddress(str)
+		return err == nil && addr.Address == str, true
+	case FORMAT_UUID:
+		return uuidPattern.MatchString(str), true
+	case FORMAT_DATE:
+		_, err := time.Parse("2006-01-02", str)
+		return err == nil, true
+	case FORMAT_DATE_TIME:
+		_, err := time.Parse(time.RFC3339, str)
+		return err == nil, true
+	case FORMAT_IPV4:
+		ip := net.ParseIP(str)
+		return ip != nil && ip.To4() != nil && !strings.Contains(str, ":"), true
+	case FORMAT_IPV6:
+		ip := net.ParseIP(str)
+		return ip != nil && strings.Contains(str, ":"), true
+	default:
+		return false, false
+	}
+}
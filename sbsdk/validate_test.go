@@ -0,0 +1,187 @@
+package sbsdk
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		valid     []cty.Value
+		invalid   []cty.Value
+	}{
+		{
+			name:      "enum",
+			validator: EnumValidator("draft", "10", "true"),
+			valid:     []cty.Value{cty.StringVal("draft"), cty.NumberIntVal(10), cty.True},
+			invalid:   []cty.Value{cty.StringVal("Draft"), cty.NumberIntVal(11), cty.False},
+		},
+		{
+			name:      "regex",
+			validator: RegexValidator(`^[a-z]+-[0-9]+$`),
+			valid:     []cty.Value{cty.StringVal("user-1")},
+			invalid:   []cty.Value{cty.StringVal("User-1"), cty.StringVal("user-")},
+		},
+		{
+			name:      "range",
+			validator: RangeValidator(1, 10),
+			valid:     []cty.Value{cty.NumberIntVal(1), cty.NumberFloatVal(9.5), cty.NumberIntVal(10)},
+			invalid:   []cty.Value{cty.NumberIntVal(0), cty.NumberFloatVal(10.01)},
+		},
+		{
+			name:      "length",
+			validator: LengthValidator(2, 3),
+			valid:     []cty.Value{cty.StringVal("né"), cty.ListVal([]cty.Value{cty.True, cty.True, cty.False})},
+			invalid:   []cty.Value{cty.StringVal("a"), cty.StringVal("abcd"), cty.ListVal([]cty.Value{cty.True})},
+		},
+		{
+			name:      "url",
+			validator: FormatValidator(FORMAT_URL),
+			valid:     []cty.Value{cty.StringVal("https://example.com/users")},
+			invalid:   []cty.Value{cty.StringVal("example.com"), cty.StringVal("/users")},
+		},
+		{
+			name:      "email",
+			validator: FormatValidator(FORMAT_EMAIL),
+			valid:     []cty.Value{cty.StringVal("ada@example.com")},
+			invalid:   []cty.Value{cty.StringVal("Ada <ada@example.com>"), cty.StringVal("ada")},
+		},
+		{
+			name:      "uuid",
+			validator: FormatValidator(FORMAT_UUID),
+			valid:     []cty.Value{cty.StringVal("123e4567-e89b-12d3-a456-426614174000")},
+			invalid:   []cty.Value{cty.StringVal("123e4567")},
+		},
+		{
+			name:      "date",
+			validator: FormatValidator(FORMAT_DATE),
+			valid:     []cty.Value{cty.StringVal("2023-02-28")},
+			invalid:   []cty.Value{cty.StringVal("2023-02-30"), cty.StringVal("28/02/2023")},
+		},
+		{
+			name:      "date-time",
+			validator: FormatValidator(FORMAT_DATE_TIME),
+			valid:     []cty.Value{cty.StringVal("2023-02-28T10:00:00Z")},
+			invalid:   []cty.Value{cty.StringVal("2023-02-28")},
+		},
+		{
+			name:      "ipv4",
+			validator: FormatValidator(FORMAT_IPV4),
+			valid:     []cty.Value{cty.StringVal("10.0.0.1")},
+			invalid:   []cty.Value{cty.StringVal("::ffff:10.0.0.1"), cty.StringVal("10.0.0")},
+		},
+		{
+			name:      "ipv6",
+			validator: FormatValidator(FORMAT_IPV6),
+			valid:     []cty.Value{cty.StringVal("2001:db8::1")},
+			invalid:   []cty.Value{cty.StringVal("10.0.0.1")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, val := range tt.valid {
+				if diags := tt.validator.validate(val, nil); diags.HasErrors() {
+					t.Errorf("%#v: %s", val, diags)
+				}
+			}
+			for _, val := range tt.invalid {
+				if diags := tt.validator.validate(val, nil); !diags.HasErrors() {
+					t.Errorf("%#v: got no diagnostics", val)
+				}
+			}
+			if diags := tt.validator.validate(cty.NullVal(cty.String), nil); diags.HasErrors() {
+				t.Errorf("null: %s", diags)
+			}
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	schema := &ObjectSchema{Attributes: map[string]Schema{
+		"name": &AttrSchema{Name: "name", Type: String, Validators: []Validator{LengthValidator(1, 5)}},
+		"header": ListBlockSchema("header", &ObjectSchema{Attributes: map[string]Schema{
+			"value": &AttrSchema{Name: "value", Type: String, Validators: []Validator{EnumValidator("a", "b")}},
+		}}, 0, 0),
+		"route": &BlockSchema{
+			Name:     "route",
+			Mode:     BLOCK_MODE_MAP,
+			Labels:   []string{"method"},
+			Required: true,
+			Nested: &ObjectSchema{Attributes: map[string]Schema{
+				"timeout": &AttrSchema{Name: "timeout", Type: Number, Validators: []Validator{RangeValidator(1, 60)}},
+			}},
+		},
+	}}
+	route := func(timeout int64) cty.Value {
+		return cty.MapVal(map[string]cty.Value{
+			"GET": cty.ObjectVal(map[string]cty.Value{"timeout": cty.NumberIntVal(timeout)}),
+		})
+	}
+	header := func(values ...string) cty.Value {
+		var list []cty.Value
+		for _, v := range values {
+			list = append(list, cty.ObjectVal(map[string]cty.Value{"value": cty.StringVal(v)}))
+		}
+		return cty.ListVal(list)
+	}
+	val := cty.ObjectVal(map[string]cty.Value{
+		"name":   cty.StringVal("ada"),
+		"header": header("a", "b"),
+		"route":  route(30),
+	})
+	if diags := ValidateConfig(schema, val); len(diags) > 0 {
+		t.Fatal(diags)
+	}
+
+	tests := map[string]struct {
+		val  cty.Value
+		path cty.Path
+	}{
+		"attribute": {
+			val:  cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("ada lovelace"), "header": header("a"), "route": route(30)}),
+			path: cty.GetAttrPath("name"),
+		},
+		"list block": {
+			val:  cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("ada"), "header": header("a", "c"), "route": route(30)}),
+			path: cty.GetAttrPath("header").IndexInt(1).GetAttr("value"),
+		},
+		"map block": {
+			val:  cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("ada"), "header": header("a"), "route": route(90)}),
+			path: cty.GetAttrPath("route").IndexString("GET").GetAttr("timeout"),
+		},
+		"missing required map block": {
+			val: cty.ObjectVal(map[string]cty.Value{
+				"name":   cty.StringVal("ada"),
+				"header": header("a"),
+				"route":  cty.MapValEmpty(cty.Object(map[string]cty.Type{"timeout": cty.Number})),
+			}),
+			path: cty.GetAttrPath("route"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assertDiagnosticPath(t, ValidateConfig(schema, tt.val), tt.path)
+		})
+	}
+}
+
+func TestCheckSchemaReportsInvalidValidators(t *testing.T) {
+	tests := map[string]Validator{
+		"pattern": RegexValidator(`^[a-z`),
+		"format":  FormatValidator("phone"),
+		"kind":    {Kind: "checksum"},
+	}
+	for name, validator := range tests {
+		t.Run(name, func(t *testing.T) {
+			schema := &ObjectSchema{Attributes: map[string]Schema{"name": &AttrSchema{Name: "name", Type: String, Validators: []Validator{validator}}}}
+			assertDiagnosticPath(t, CheckSchema(schema), cty.GetAttrPath("name"))
+		})
+	}
+	valid := &ObjectSchema{Attributes: map[string]Schema{"name": &AttrSchema{Name: "name", Type: String, Validators: []Validator{RegexValidator(`^[a-z]+$`)}}}}
+	if diags := CheckSchema(valid); len(diags) > 0 {
+		t.Error(diags)
+	}
+}
@@ -0,0 +1,201 @@
+package sbsdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// unmarshalValue decodes cty JSON into a value of the given type, then fills in the defaults
+// and applies the sensitive marks declared by t. ctyType may be a type constraint with optional
+// attributes, such as the one returned by Type.ToCty, and values are decoded without them. cty's
+// JSON decoder decodes omitted object attributes as null, so attributes that t requires are
+// checked to be present first.
+func unmarshalValue(data []byte, ctyType cty.Type, t Type) (cty.Value, error) {
+	val, err := ctyjson.Unmarshal(data, ctyType.WithoutOptionalAttributesDeep())
+	if err != nil {
+		return cty.NilVal, err
+	}
+	if err := checkRequiredAttrs(data, t, nil); err != nil {
+		return cty.NilVal, err
+	}
+	val, err = applyDefaults(val, t)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return markSensitive(val, t), nil
+}
+
+// checkRequiredAttrs returns an error for the first object attribute in data, at any depth,
+// that t requires but data leaves out. Attributes set to null are left to the caller.
+func checkRequiredAttrs(data json.RawMessage, t Type, path cty.Path) error {
+	switch t.TypeName {
+	case OBJECT_TYPE:
+		var attrs map[string]json.RawMessage
+		if t.NestedValues == nil || json.Unmarshal(data, &attrs) != nil || attrs == nil {
+			return nil
+		}
+		optional := t.optionalAttrs()
+		names := make([]string, 0, len(*t.NestedValues))
+		for name := range *t.NestedValues {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			attrPath := append(path.Copy(), cty.GetAttrStep{Name: name})
+			attr, ok := attrs[name]
+			if !ok {
+				if containsString(optional, name) {
+					continue
+				}
+				return attrPath.NewErrorf("attribute %q is required", name)
+			}
+			if err := checkRequiredAttrs(attr, (*t.NestedValues)[name], attrPath); err != nil {
+				return err
+			}
+		}
+	case LIST_TYPE, SET_TYPE, TUPLE_TYPE:
+		var elements []json.RawMessage
+		if json.Unmarshal(data, &elements) != nil {
+			return nil
+		}
+		for i, element := range elements {
+			elementType := t.InternalType
+			if t.TypeName == TUPLE_TYPE {
+				if t.ElementTypes == nil || i >= len(*t.ElementTypes) {
+					return nil
+				}
+				elementType = &(*t.ElementTypes)[i]
+			}
+			if elementType == nil {
+				return nil
+			}
+			//set elements have no index, so their path ends in an unknown key
+			key := cty.DynamicVal
+			if t.TypeName != SET_TYPE {
+				key = cty.NumberIntVal(int64(i))
+			}
+			if err := checkRequiredAttrs(element, *elementType, append(path.Copy(), cty.IndexStep{Key: key})); err != nil {
+				return err
+			}
+		}
+	case MAP_TYPE:
+		var elements map[string]json.RawMessage
+		if t.InternalType == nil || json.Unmarshal(data, &elements) != nil {
+			return nil
+		}
+		keys := make([]string, 0, len(elements))
+		for key := range elements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := checkRequiredAttrs(elements[key], *t.InternalType, append(path.Copy(), cty.IndexStep{Key: cty.StringVal(key)})); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// marshalValue fills in the defaults declared by t, converts val to the given type so that
+// omitted optional attributes become null, and encodes the result as cty JSON. cty JSON can't
+// carry marks, so they are dropped; sensitivePaths records where the sensitive ones were.
+func marshalValue(val cty.Value, ctyType cty.Type, t Type) ([]byte, error) {
+	val, _ = val.UnmarkDeep()
+	val, err := convert.Convert(val, ctyType)
+	if err != nil {
+		return nil, err
+	}
+	val, err = applyDefaults(val, t)
+	if err != nil {
+		return nil, err
+	}
+	return ctyjson.Marshal(val, ctyType.WithoutOptionalAttributesDeep())
+}
+
+// applyDefaults returns val with every null object attribute that has a default in t replaced
+// by that default, at any depth.
+func applyDefaults(val cty.Value, t Type) (cty.Value, error) {
+	if val.IsNull() || !val.IsKnown() {
+		return val, nil
+	}
+	switch t.TypeName {
+	case OBJECT_TYPE:
+		if t.NestedValues == nil || !val.Type().IsObjectType() {
+			return val, nil
+		}
+		attrs := val.AsValueMap()
+		for name, attrType := range *t.NestedValues {
+			attr, ok := attrs[name]
+			if !ok {
+				continue
+			}
+			if attr.IsNull() && t.Defaults != nil {
+				if data, ok := (*t.Defaults)[name]; ok {
+					defaultVal, err := unmarshalValue(data, attrType.valueType(), attrType)
+					if err != nil {
+						return cty.NilVal, fmt.Errorf("invalid default for attribute %q: %w", name, err)
+					}
+					attrs[name] = defaultVal
+					continue
+				}
+			}
+			attrVal, err := applyDefaults(attr, attrType)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			attrs[name] = attrVal
+		}
+		if len(attrs) == 0 {
+			return val, nil
+		}
+		return cty.ObjectVal(attrs), nil
+	case LIST_TYPE, SET_TYPE, MAP_TYPE:
+		if t.InternalType == nil || val.LengthInt() == 0 {
+			return val, nil
+		}
+		elements := make(map[string]cty.Value)
+		var list []cty.Value
+		iter := val.ElementIterator()
+		for iter.Next() {
+			k, v := iter.Element()
+			el, err := applyDefaults(v, *t.InternalType)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			if t.TypeName == MAP_TYPE {
+				elements[k.AsString()] = el
+			} else {
+				list = append(list, el)
+			}
+		}
+		switch t.TypeName {
+		case MAP_TYPE:
+			return cty.MapVal(elements), nil
+		case SET_TYPE:
+			return cty.SetVal(list), nil
+		default:
+			return cty.ListVal(list), nil
+		}
+	case TUPLE_TYPE:
+		if t.ElementTypes == nil || val.LengthInt() != len(*t.ElementTypes) {
+			return val, nil
+		}
+		elements := make([]cty.Value, 0, len(*t.ElementTypes))
+		for i, elementType := range *t.ElementTypes {
+			el, err := applyDefaults(val.Index(cty.NumberIntVal(int64(i))), elementType)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elements = append(elements, el)
+		}
+		return cty.TupleVal(elements), nil
+	default:
+		return val, nil
+	}
+}